@@ -0,0 +1,54 @@
+package interfaces
+
+import "errors"
+
+var (
+	// ErrRequeue is returned (possibly wrapped) by a TriggerCallback to ask
+	// the trigger to redeliver the event later.
+	ErrRequeue = errors.New("interfaces: requeue event")
+	// ErrDeadLetter is returned (possibly wrapped) by a TriggerCallback to
+	// ask the trigger to give up on the event, e.g. by routing it to a
+	// dead-letter queue.
+	ErrDeadLetter = errors.New("interfaces: dead-letter event")
+)
+
+// CallbackOutcome is how a trigger should settle an event after its callback
+// returned.
+type CallbackOutcome int
+
+const (
+	// OutcomeAck means the event was processed and may be committed.
+	OutcomeAck CallbackOutcome = iota
+	// OutcomeRequeue means the event should be redelivered.
+	OutcomeRequeue
+	// OutcomeDeadLetter means the event should not be retried.
+	OutcomeDeadLetter
+)
+
+func (o CallbackOutcome) String() string {
+	switch o {
+	case OutcomeAck:
+		return "ack"
+	case OutcomeRequeue:
+		return "requeue"
+	case OutcomeDeadLetter:
+		return "dead-letter"
+	}
+
+	return "unknown"
+}
+
+// OutcomeOf maps the error returned by a TriggerCallback to an outcome for
+// at-least-once triggers: nil acknowledges the event, an error matching
+// ErrDeadLetter dead-letters it, and any other error, including ErrRequeue,
+// requeues it.
+func OutcomeOf(err error) CallbackOutcome {
+	switch {
+	case err == nil:
+		return OutcomeAck
+	case errors.Is(err, ErrDeadLetter):
+		return OutcomeDeadLetter
+	default:
+		return OutcomeRequeue
+	}
+}
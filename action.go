@@ -8,8 +8,13 @@ import (
 	"github.com/dukex/operion/pkg/models"
 )
 
+// Action is a single step of a workflow.
+//
+// Actions that hold resources such as connections or file handles may
+// implement io.Closer; the engine calls Close, through CloseAction, when it
+// unloads the workflow.
 type Action interface {
-	Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (any, error)
+	Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error)
 	Validate(ctx context.Context) error
 }
 
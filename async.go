@@ -0,0 +1,76 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// DefaultPollInterval is used by Await when no positive interval is given.
+const DefaultPollInterval = time.Second
+
+// Future tracks a long-running job started by an AsyncAction.
+type Future interface {
+	// Poll reports whether the job has finished and, if so, its result or
+	// error. An error with done == false means the status could not be
+	// determined and polling may be retried.
+	Poll(ctx context.Context) (done bool, result *ActionResult, err error)
+	// Cancel asks the external system to abort the job.
+	Cancel(ctx context.Context) error
+	// Token returns an opaque encoding of the future that the engine can
+	// persist and hand to FutureResumer.ResumeFuture after a restart.
+	Token() []byte
+}
+
+// AsyncAction is an optional interface for actions that start external jobs
+// (e.g. a batch render) and should not block the workflow while they run.
+// ExecuteAsync starts the job and returns without waiting for it.
+type AsyncAction interface {
+	ExecuteAsync(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (Future, error)
+}
+
+// FutureResumer is an optional interface for async actions that can rebuild a
+// Future from a token returned by Future.Token, so polling can continue after
+// an engine restart.
+type FutureResumer interface {
+	ResumeFuture(ctx context.Context, token []byte) (Future, error)
+}
+
+// Await polls f every interval until it is done and returns its result. If
+// ctx is done first, Await returns ctx's error, joined with the error of the
+// last poll if it failed, and leaves the job running; call f.Cancel to abort
+// it. Poll errors with done == false are retried, so bound persistent
+// failures with ctx's deadline.
+func Await(ctx context.Context, f Future, interval time.Duration) (*ActionResult, error) {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var pollErr error
+
+	for {
+		done, result, err := f.Poll(ctx)
+		if done {
+			return result, err
+		}
+
+		pollErr = err
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			if pollErr != nil {
+				return nil, errors.Join(ctx.Err(), fmt.Errorf("interfaces: last poll failed: %w", pollErr))
+			}
+
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// BatchResult is the outcome of one item of a batch execution. Err is non-nil
+// if the item failed.
+type BatchResult struct {
+	Result *ActionResult
+	Err    error
+}
+
+// BatchAction is an optional interface for actions that can process many
+// execution contexts more efficiently than one Execute call per item, e.g.
+// bulk database inserts.
+//
+// ExecuteBatch returns one BatchResult per input, in the same order. Item
+// failures are reported in BatchResult.Err; the returned error is reserved for
+// failures of the batch as a whole.
+type BatchAction interface {
+	ExecuteBatch(ctx context.Context, executionCtxs []models.ExecutionContext, logger *slog.Logger) ([]BatchResult, error)
+}
+
+// ExecuteMany executes a for every execution context, using ExecuteBatch if a
+// implements BatchAction and calling Execute per item otherwise, as for the
+// wrappers in this package. Once ctx is done, the remaining items fail with
+// ctx's error.
+func ExecuteMany(ctx context.Context, a Action, executionCtxs []models.ExecutionContext, logger *slog.Logger) ([]BatchResult, error) {
+	if ba, ok := a.(BatchAction); ok {
+		results, err := ba.ExecuteBatch(ctx, executionCtxs, logger)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(results) != len(executionCtxs) {
+			return nil, fmt.Errorf("interfaces: batch returned %d results for %d inputs", len(results), len(executionCtxs))
+		}
+
+		return results, nil
+	}
+
+	results := make([]BatchResult, len(executionCtxs))
+
+	for i, executionCtx := range executionCtxs {
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+
+			continue
+		}
+
+		results[i].Result, results[i].Err = a.Execute(ctx, executionCtx, logger)
+	}
+
+	return results, nil
+}
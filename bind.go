@@ -0,0 +1,103 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// Bind decodes config into out, typically inside ActionFactory.Create or
+// TriggerFactory.Create.
+//
+// Fields of out tagged default:"VALUE" are set to VALUE before decoding, so
+// they keep it when config has no entry for them. Values are parsed as JSON
+// literals except for string fields, which take VALUE verbatim, and
+// time.Duration fields, which accept time.ParseDuration syntax. After
+// decoding, Bind calls out's Validate() error method if it has one.
+//
+// A type mismatch is reported with the name of the offending field.
+func Bind[T any](config map[string]any, out *T) error {
+	if out == nil {
+		return errors.New("interfaces: Bind called with nil output")
+	}
+
+	if err := applyDefaults(reflect.ValueOf(out).Elem()); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("interfaces: encoding config: %w", err)
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			return fmt.Errorf("interfaces: config field %q: cannot use %s as %s", typeErr.Field, typeErr.Value, typeErr.Type)
+		}
+
+		return fmt.Errorf("interfaces: decoding config: %w", err)
+	}
+
+	if v, ok := any(out).(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
+
+func applyDefaults(v reflect.Value) error {
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	t := v.Type()
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		fv := v.Field(i)
+
+		if !field.IsExported() || !fv.CanSet() {
+			continue
+		}
+
+		def, ok := field.Tag.Lookup("default")
+		if !ok {
+			if err := applyDefaults(fv); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := setDefault(fv, def); err != nil {
+			return fmt.Errorf("interfaces: default for field %q: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func setDefault(fv reflect.Value, def string) error {
+	switch {
+	case fv.Type() == durationType:
+		d, err := time.ParseDuration(def)
+		if err != nil {
+			return err
+		}
+
+		fv.SetInt(int64(d))
+
+		return nil
+	case fv.Kind() == reflect.String:
+		fv.SetString(def)
+
+		return nil
+	}
+
+	return json.Unmarshal([]byte(def), fv.Addr().Interface())
+}
@@ -0,0 +1,249 @@
+package interfaces
+
+import (
+	"container/list"
+	"context"
+	"log/slog"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// Cache stores action results for CacheMiddleware. Implementations must be
+// safe for concurrent use. A zero ttl means the entry does not expire.
+type Cache interface {
+	Get(key string) (*ActionResult, bool)
+	Set(key string, result *ActionResult, ttl time.Duration)
+}
+
+// CacheKeyFunc derives the cache key of an execution. It returns false when
+// the inputs are not cacheable.
+type CacheKeyFunc func(executionCtx models.ExecutionContext) (string, bool)
+
+// CacheOption configures CacheMiddleware.
+type CacheOption func(*cacheConfig)
+
+type cacheConfig struct {
+	ttl time.Duration
+}
+
+// WithCacheTTL sets how long cached results stay valid. By default they do
+// not expire.
+func WithCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *cacheConfig) {
+		c.ttl = ttl
+	}
+}
+
+// CacheMiddleware memoizes the results of pure actions, such as lookups that
+// always return the same output for the same input.
+//
+// Executions for which keyFn returns false bypass the cache. Only successful
+// results are stored. Results are deep-copied when stored and when returned,
+// so callers cannot modify a cached entry. Dry runs bypass the cache, so their
+// results are never served to, or taken from, real executions.
+func CacheMiddleware(cache Cache, keyFn CacheKeyFunc, opts ...CacheOption) ActionMiddleware {
+	var cfg cacheConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(next Action) Action {
+		around := func(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger, call ExecuteFunc) (*ActionResult, error) {
+			key, ok := keyFn(executionCtx)
+			if !ok {
+				return call(ctx, executionCtx, logger)
+			}
+
+			if cached, ok := cache.Get(key); ok {
+				return cloneResult(cached), nil
+			}
+
+			result, err := call(ctx, executionCtx, logger)
+			if err != nil {
+				return nil, err
+			}
+
+			cache.Set(key, cloneResult(result), cfg.ttl)
+
+			return result, nil
+		}
+
+		return wrappedAction{inner: next, around: around, plainDryRun: true}
+	}
+}
+
+// cloneResult deep-copies r. Maps, slices, arrays, pointers and exported
+// struct fields are copied; unexported struct fields are shared.
+func cloneResult(r *ActionResult) *ActionResult {
+	if r == nil {
+		return nil
+	}
+
+	clone := *r
+	clone.Output = deepCopy(r.Output)
+
+	if r.Metadata != nil {
+		clone.Metadata, _ = deepCopy(r.Metadata).(map[string]any)
+	}
+
+	return &clone
+}
+
+func deepCopy(v any) any {
+	if v == nil {
+		return nil
+	}
+
+	return deepCopyValue(reflect.ValueOf(v)).Interface()
+}
+
+func deepCopyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+
+		out := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for it := v.MapRange(); it.Next(); {
+			out.SetMapIndex(it.Key(), deepCopyValue(it.Value()))
+		}
+
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			out.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+
+		return out
+	case reflect.Array:
+		out := reflect.New(v.Type()).Elem()
+		for i := range v.Len() {
+			out.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+
+		return out
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+
+		out := reflect.New(v.Type().Elem())
+		out.Elem().Set(deepCopyValue(v.Elem()))
+
+		return out
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+
+		out := reflect.New(v.Type()).Elem()
+		out.Set(deepCopyValue(v.Elem()))
+
+		return out
+	case reflect.Struct:
+		out := reflect.New(v.Type()).Elem()
+		out.Set(v)
+
+		for i := range v.NumField() {
+			if out.Field(i).CanSet() {
+				out.Field(i).Set(deepCopyValue(v.Field(i)))
+			}
+		}
+
+		return out
+	}
+
+	return v
+}
+
+// LRUCache is an in-memory Cache that evicts the least recently used entry
+// once it holds maxEntries entries. It is safe for concurrent use.
+type LRUCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	order   *list.List // front is most recently used
+	entries map[string]*list.Element
+}
+
+type lruEntry struct {
+	key       string
+	result    *ActionResult
+	expiresAt time.Time
+}
+
+// NewLRUCache returns an LRUCache holding up to maxEntries results. A
+// non-positive maxEntries means no limit.
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+func (c *LRUCache) Get(key string) (*ActionResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if !entry.expiresAt.IsZero() && !time.Now().Before(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+
+	return entry.result, true
+}
+
+func (c *LRUCache) Set(key string, result *ActionResult, ttl time.Duration) {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*lruEntry)
+		entry.result, entry.expiresAt = result, expiresAt
+		c.order.MoveToFront(elem)
+
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, result: result, expiresAt: expiresAt})
+
+	if c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// that have not been evicted yet.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
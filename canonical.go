@@ -0,0 +1,101 @@
+package interfaces
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// canonicalResult is the wire format of MarshalCanonical.
+type canonicalResult struct {
+	DurationNS int64          `json:"duration_ns"`
+	Metadata   map[string]any `json:"metadata"`
+	Output     any            `json:"output"`
+	Skipped    bool           `json:"skipped"`
+}
+
+// MarshalCanonical encodes r as deterministic JSON: object keys are sorted at
+// every level and HTML characters are not escaped, so equal results always
+// produce identical bytes.
+//
+// Output and Metadata must hold JSON-serializable values; channels, functions,
+// complex numbers and non-finite floats are rejected with an error naming the
+// field.
+func (r *ActionResult) MarshalCanonical() ([]byte, error) {
+	output, err := canonicalValue(r.Output)
+	if err != nil {
+		return nil, fmt.Errorf("interfaces: ActionResult.Output is not JSON-serializable: %w", err)
+	}
+
+	var metadata map[string]any
+	if r.Metadata != nil {
+		v, err := canonicalValue(r.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("interfaces: ActionResult.Metadata is not JSON-serializable: %w", err)
+		}
+
+		metadata, _ = v.(map[string]any)
+	}
+
+	return encodeCanonical(canonicalResult{
+		DurationNS: int64(r.Duration),
+		Metadata:   metadata,
+		Output:     output,
+		Skipped:    r.Skipped,
+	})
+}
+
+// UnmarshalActionResult decodes data produced by MarshalCanonical. Output and
+// Metadata come back as generic JSON values (map[string]any, []any, string,
+// bool, nil) with numbers as json.Number, so re-encoding the result yields
+// the original bytes.
+func UnmarshalActionResult(data []byte) (*ActionResult, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	dec.DisallowUnknownFields()
+
+	var cr canonicalResult
+	if err := dec.Decode(&cr); err != nil {
+		return nil, fmt.Errorf("interfaces: decoding ActionResult: %w", err)
+	}
+
+	return &ActionResult{
+		Output:   cr.Output,
+		Metadata: cr.Metadata,
+		Duration: time.Duration(cr.DurationNS),
+		Skipped:  cr.Skipped,
+	}, nil
+}
+
+// canonicalValue round-trips v through JSON so that structs become maps,
+// whose keys encoding/json sorts, while numbers keep their exact text.
+func canonicalValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var out any
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func encodeCanonical(v any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,62 @@
+package interfaces
+
+// Capability is a bitmask of the optional interfaces an action supports.
+type Capability uint32
+
+const (
+	// CapRetry means the action's factory declares a RetryPolicy.
+	CapRetry Capability = 1 << iota
+	// CapStream means the action implements StreamingAction.
+	CapStream
+	// CapIdempotent means the action implements IdempotentAction.
+	CapIdempotent
+	// CapDryRun means the action implements DryRunner.
+	CapDryRun
+	// CapBatch means the action implements BatchAction.
+	CapBatch
+)
+
+// Has reports whether c includes all of the capabilities in other.
+func (c Capability) Has(other Capability) bool {
+	return c&other == other
+}
+
+// CapabilityProvider is an optional interface for factories that declare the
+// capabilities of the actions they create.
+type CapabilityProvider interface {
+	Capabilities() Capability
+}
+
+// DetectCapabilities probes f, the factory that created a, for
+// RetryPolicyProvider and a's chain (see ActionAs) for the other known
+// optional interfaces. It is the fallback for factories that don't implement
+// CapabilityProvider. f may be nil, in which case CapRetry is never set.
+//
+// The result describes the action under any wrappers. Wrappers that cannot
+// decorate a call, such as a batch, do not expose it themselves; see
+// Unwrapper.
+func DetectCapabilities(f ActionFactory, a Action) Capability {
+	var caps Capability
+
+	if _, ok := RetryPolicyOf(f); ok {
+		caps |= CapRetry
+	}
+
+	if _, ok := ActionAs[StreamingAction](a); ok {
+		caps |= CapStream
+	}
+
+	if _, ok := ActionAs[IdempotentAction](a); ok {
+		caps |= CapIdempotent
+	}
+
+	if _, ok := ActionAs[DryRunner](a); ok {
+		caps |= CapDryRun
+	}
+
+	if _, ok := ActionAs[BatchAction](a); ok {
+		caps |= CapBatch
+	}
+
+	return caps
+}
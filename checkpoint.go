@@ -0,0 +1,78 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+)
+
+// Checkpointer persists an opaque cursor (offset, last-seen timestamp, ...)
+// for a resumable trigger, so that after an engine restart the trigger
+// resumes where it left off instead of reprocessing or skipping events. The
+// engine provides the store; the trigger owns the encoding of the payload.
+type Checkpointer interface {
+	LoadCheckpoint(ctx context.Context) ([]byte, error)
+	SaveCheckpoint(ctx context.Context, checkpoint []byte) error
+}
+
+// NoopCheckpointer is a Checkpointer that stores nothing. It is used for
+// triggers that don't need checkpointing.
+type NoopCheckpointer struct{}
+
+func (NoopCheckpointer) LoadCheckpoint(context.Context) ([]byte, error) {
+	return nil, nil
+}
+
+func (NoopCheckpointer) SaveCheckpoint(context.Context, []byte) error {
+	return nil
+}
+
+// ResumableTrigger is an optional interface for triggers that can resume from
+// a saved checkpoint.
+//
+// StartFrom is Start with the checkpoint saved by a previous run, or nil if
+// none has been saved yet. The trigger reports its progress by setting
+// TriggerEvent.Checkpoint to the cursor to resume from once that event has
+// been processed.
+type ResumableTrigger interface {
+	Trigger
+	StartFrom(ctx context.Context, checkpoint []byte, callback TriggerCallback) error
+}
+
+// StartWithCheckpoints starts t, resuming a ResumableTrigger from the
+// checkpoint loaded from cp. Other triggers are started with Start. The
+// trigger wrappers in this package pass the checkpoint on to the trigger they
+// wrap (see TriggerUnwrapper).
+//
+// The checkpoint of an event is saved only after the callback for it has
+// returned nil, so a checkpoint never covers an event that was not processed
+// successfully. Checkpoints are saved in the order their callbacks return; a
+// trigger that runs callbacks concurrently should only attach a checkpoint to
+// an event once every earlier event has been processed. If saving fails, the
+// callback returns the error so the event is requeued (see OutcomeOf) and
+// may be processed again.
+func StartWithCheckpoints(ctx context.Context, t Trigger, cp Checkpointer, callback TriggerCallback) error {
+	if !supportsResume(t) {
+		return t.Start(ctx, callback)
+	}
+
+	checkpoint, err := cp.LoadCheckpoint(ctx)
+	if err != nil {
+		return fmt.Errorf("interfaces: load checkpoint: %w", err)
+	}
+
+	return startFrom(ctx, t, checkpoint, func(ctx context.Context, event TriggerEvent) error {
+		if err := callback(ctx, event); err != nil {
+			return err
+		}
+
+		if event.Checkpoint == nil {
+			return nil
+		}
+
+		if err := cp.SaveCheckpoint(ctx, event.Checkpoint); err != nil {
+			return fmt.Errorf("interfaces: save checkpoint: %w", err)
+		}
+
+		return nil
+	})
+}
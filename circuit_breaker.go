@@ -0,0 +1,131 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// ErrCircuitOpen is returned by actions wrapped with CircuitBreakerMiddleware
+// while their circuit is open.
+var ErrCircuitOpen = errors.New("interfaces: circuit breaker is open")
+
+// CircuitBreakerOptions configures CircuitBreakerMiddleware.
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failures that opens the
+	// circuit. Defaults to 5.
+	FailureThreshold int
+	// Cooldown is how long the circuit stays open before a single trial
+	// execution is let through. Defaults to 30s.
+	Cooldown time.Duration
+	// IsFailure reports whether err counts as a failure. A nil IsFailure
+	// counts every error.
+	IsFailure func(err error) bool
+}
+
+// CircuitBreakerMiddleware stops calling an action that keeps failing.
+//
+// After FailureThreshold consecutive failures the circuit opens and Execute
+// returns ErrCircuitOpen without invoking the action. Once Cooldown has
+// elapsed the circuit is half-open: one execution is let through, and its
+// outcome closes the circuit again or reopens it for another cooldown. Each
+// action the middleware is applied to gets its own breaker.
+func CircuitBreakerMiddleware(opts CircuitBreakerOptions) ActionMiddleware {
+	if opts.FailureThreshold <= 0 {
+		opts.FailureThreshold = 5
+	}
+
+	if opts.Cooldown <= 0 {
+		opts.Cooldown = 30 * time.Second
+	}
+
+	return func(next Action) Action {
+		cb := &circuitBreaker{opts: opts}
+
+		return wrapAction(next, func(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger, call ExecuteFunc) (*ActionResult, error) {
+			if !cb.allow() {
+				return nil, ErrCircuitOpen
+			}
+
+			recorded := false
+			defer func() {
+				// A panicking execution counts as a failure, so a
+				// half-open circuit is not left waiting for a trial
+				// that never reports back.
+				if !recorded {
+					cb.record(true)
+				}
+			}()
+
+			result, err := call(ctx, executionCtx, logger)
+			cb.record(err != nil && (opts.IsFailure == nil || opts.IsFailure(err)))
+			recorded = true
+
+			return result, err
+		})
+	}
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type circuitBreaker struct {
+	opts CircuitBreakerOptions
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+// allow reports whether an execution may proceed, moving an open circuit to
+// half-open once the cooldown has elapsed.
+func (cb *circuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case circuitOpen:
+		if time.Since(cb.openedAt) < cb.opts.Cooldown {
+			return false
+		}
+
+		cb.state = circuitHalfOpen
+
+		return true
+	case circuitHalfOpen:
+		// A trial execution is already in flight.
+		return false
+	}
+
+	return true
+}
+
+// record updates the breaker with the outcome of an execution.
+func (cb *circuitBreaker) record(failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if !failed {
+		cb.state = circuitClosed
+		cb.failures = 0
+
+		return
+	}
+
+	cb.failures++
+
+	if cb.state == circuitHalfOpen || cb.failures >= cb.opts.FailureThreshold {
+		cb.state = circuitOpen
+		cb.openedAt = time.Now()
+	}
+}
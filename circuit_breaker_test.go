@@ -0,0 +1,172 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// funcAction is an Action backed by a function, for tests.
+type funcAction ExecuteFunc
+
+func (f funcAction) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	return f(ctx, executionCtx, logger)
+}
+
+func (funcAction) Validate(context.Context) error { return nil }
+
+var errTest = errors.New("test failure")
+
+// scriptedAction fails while fail is set and counts its executions.
+type scriptedAction struct {
+	fail  atomic.Bool
+	calls atomic.Int64
+}
+
+func (a *scriptedAction) Execute(context.Context, models.ExecutionContext, *slog.Logger) (*ActionResult, error) {
+	a.calls.Add(1)
+
+	if a.fail.Load() {
+		return nil, errTest
+	}
+
+	return &ActionResult{}, nil
+}
+
+func (*scriptedAction) Validate(context.Context) error { return nil }
+
+func execute(a Action) error {
+	_, err := a.Execute(context.Background(), models.ExecutionContext{}, slog.Default())
+	return err
+}
+
+func TestCircuitBreakerOpensAfterThreshold(t *testing.T) {
+	inner := &scriptedAction{}
+	inner.fail.Store(true)
+
+	a := CircuitBreakerMiddleware(CircuitBreakerOptions{FailureThreshold: 3, Cooldown: time.Hour})(inner)
+
+	for i := range 3 {
+		if err := execute(a); !errors.Is(err, errTest) {
+			t.Fatalf("execution %d: err = %v, want errTest", i, err)
+		}
+	}
+
+	if err := execute(a); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err = %v, want ErrCircuitOpen", err)
+	}
+
+	if got := inner.calls.Load(); got != 3 {
+		t.Errorf("inner calls = %d, want 3", got)
+	}
+}
+
+func TestCircuitBreakerSuccessResetsFailures(t *testing.T) {
+	inner := &scriptedAction{}
+	a := CircuitBreakerMiddleware(CircuitBreakerOptions{FailureThreshold: 2, Cooldown: time.Hour})(inner)
+
+	for i := range 5 {
+		inner.fail.Store(i%2 == 0)
+		_ = execute(a)
+	}
+
+	if err := execute(a); errors.Is(err, ErrCircuitOpen) {
+		t.Fatal("circuit opened without consecutive failures")
+	}
+}
+
+func TestCircuitBreakerIsFailure(t *testing.T) {
+	inner := &scriptedAction{}
+	inner.fail.Store(true)
+
+	a := CircuitBreakerMiddleware(CircuitBreakerOptions{
+		FailureThreshold: 1,
+		Cooldown:         time.Hour,
+		IsFailure:        func(err error) bool { return !errors.Is(err, errTest) },
+	})(inner)
+
+	for range 3 {
+		if err := execute(a); !errors.Is(err, errTest) {
+			t.Fatalf("err = %v, want errTest", err)
+		}
+	}
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	tests := []struct {
+		name      string
+		trialFail bool
+		wantState circuitState
+	}{
+		{name: "trial success closes", trialFail: false, wantState: circuitClosed},
+		{name: "trial failure reopens", trialFail: true, wantState: circuitOpen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := &circuitBreaker{opts: CircuitBreakerOptions{FailureThreshold: 1, Cooldown: time.Minute}}
+
+			cb.record(true)
+
+			if cb.allow() {
+				t.Fatal("open circuit allowed an execution before the cooldown")
+			}
+
+			cb.openedAt = time.Now().Add(-2 * time.Minute)
+
+			if !cb.allow() {
+				t.Fatal("circuit did not allow a trial after the cooldown")
+			}
+
+			if cb.allow() {
+				t.Fatal("half-open circuit allowed a second concurrent trial")
+			}
+
+			cb.record(tt.trialFail)
+
+			if cb.state != tt.wantState {
+				t.Errorf("state = %v, want %v", cb.state, tt.wantState)
+			}
+
+			if got, want := cb.allow(), tt.wantState == circuitClosed; got != want {
+				t.Errorf("allow() = %v after the trial, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerPanickingTrialReopens(t *testing.T) {
+	var panicking atomic.Bool
+
+	inner := funcAction(func(context.Context, models.ExecutionContext, *slog.Logger) (*ActionResult, error) {
+		if panicking.Load() {
+			panic("boom")
+		}
+
+		return nil, errTest
+	})
+
+	a := Chain(inner,
+		CircuitBreakerMiddleware(CircuitBreakerOptions{FailureThreshold: 1, Cooldown: 10 * time.Millisecond}),
+		RecoverMiddleware(),
+	)
+
+	_ = execute(a)
+
+	time.Sleep(20 * time.Millisecond)
+	panicking.Store(true)
+
+	var panicErr *PanicError
+	if err := execute(a); !errors.As(err, &panicErr) {
+		t.Fatalf("trial err = %v, want *PanicError", err)
+	}
+
+	if err := execute(a); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err after panicking trial = %v, want ErrCircuitOpen", err)
+	}
+}
@@ -0,0 +1,24 @@
+package interfaces
+
+import "context"
+
+// Cloneable is an optional interface for actions that can be copied cheaply,
+// so the engine can create an action once and clone it for every execution.
+//
+// Clone returns a copy that can be executed concurrently with, and
+// independently of, the original. The copy must not share mutable
+// per-execution state with the original, but may share immutable
+// configuration such as a compiled regular expression or an HTTP client.
+type Cloneable interface {
+	Clone() Action
+}
+
+// CloneOrCreate returns a clone of a if it implements Cloneable, and otherwise
+// creates a new action from f with config.
+func CloneOrCreate(ctx context.Context, f ActionFactory, a Action, config map[string]any) (Action, error) {
+	if c, ok := a.(Cloneable); ok {
+		return c.Clone(), nil
+	}
+
+	return f.Create(ctx, config)
+}
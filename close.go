@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"errors"
+	"io"
+)
+
+// CloseAction releases a's resources by calling its Close method if it
+// implements io.Closer, and does nothing otherwise. The wrappers and
+// combinators in this package forward Close to the actions they wrap.
+func CloseAction(a Action) error {
+	if c, ok := a.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+// CloseAll closes every action, even if some fail, and returns their errors
+// joined.
+func CloseAll(actions ...Action) error {
+	var errs []error
+
+	for _, a := range actions {
+		if err := CloseAction(a); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
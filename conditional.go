@@ -0,0 +1,44 @@
+package interfaces
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// Condition decides whether a conditional action should run.
+type Condition func(executionCtx models.ExecutionContext) (bool, error)
+
+// NewConditional returns an Action that runs then only if cond is true. When
+// cond is false it returns a result with Skipped set without invoking then;
+// when cond fails it returns cond's error. Validate always delegates to then.
+func NewConditional(cond Condition, then Action) Action {
+	return conditionalAction{cond: cond, then: then}
+}
+
+type conditionalAction struct {
+	cond Condition
+	then Action
+}
+
+func (a conditionalAction) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	ok, err := a.cond(executionCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return &ActionResult{Skipped: true}, nil
+	}
+
+	return a.then.Execute(ctx, executionCtx, logger)
+}
+
+func (a conditionalAction) Validate(ctx context.Context) error {
+	return a.then.Validate(ctx)
+}
+
+func (a conditionalAction) Close() error {
+	return CloseAction(a.then)
+}
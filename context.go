@@ -0,0 +1,34 @@
+package interfaces
+
+import "context"
+
+type (
+	executionIDKey struct{}
+	stepIDKey      struct{}
+)
+
+// WithExecutionID returns a copy of ctx carrying the ID of the current
+// workflow execution. The engine sets it before calling Execute so actions
+// and middleware can read it without touching the ExecutionContext.
+func WithExecutionID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, executionIDKey{}, id)
+}
+
+// ExecutionIDFromContext returns the execution ID stored in ctx by
+// WithExecutionID.
+func ExecutionIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(executionIDKey{}).(string)
+	return id, ok
+}
+
+// WithStepID returns a copy of ctx carrying the ID of the workflow step being
+// executed.
+func WithStepID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, stepIDKey{}, id)
+}
+
+// StepIDFromContext returns the step ID stored in ctx by WithStepID.
+func StepIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(stepIDKey{}).(string)
+	return id, ok
+}
@@ -0,0 +1,142 @@
+package interfaces
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// DedupOption configures WithDedup.
+type DedupOption func(*dedupTrigger)
+
+// WithDedupLogger sets the logger used to report dropped duplicates. It
+// defaults to slog.Default().
+func WithDedupLogger(logger *slog.Logger) DedupOption {
+	return func(t *dedupTrigger) {
+		t.logger = logger
+	}
+}
+
+// WithDedup returns a Trigger that drops events whose key was already seen
+// within window. keyFn derives the key of an event; a nil keyFn uses
+// TriggerEvent.DedupKey. Events with an empty key are always forwarded.
+//
+// Dropped duplicates are acknowledged (the callback returns nil) and logged at
+// debug level. If the callback for an event fails, its key is forgotten so a
+// redelivery of the event is processed. Keys expire after window, and expired
+// keys are evicted as new events arrive, which bounds memory use to the keys
+// seen in roughly the last two windows. Stop and Validate are delegated to t.
+func WithDedup(t Trigger, window time.Duration, keyFn func(TriggerEvent) string, opts ...DedupOption) Trigger {
+	if keyFn == nil {
+		keyFn = func(event TriggerEvent) string { return event.DedupKey }
+	}
+
+	d := &dedupTrigger{
+		inner:  t,
+		window: window,
+		keyFn:  keyFn,
+		logger: slog.Default(),
+		seen:   make(map[string]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+type dedupTrigger struct {
+	inner  Trigger
+	window time.Duration
+	keyFn  func(TriggerEvent) string
+	logger *slog.Logger
+
+	mu        sync.Mutex
+	seen      map[string]time.Time // key -> expiry
+	lastSweep time.Time
+}
+
+func (d *dedupTrigger) Start(ctx context.Context, callback TriggerCallback) error {
+	return d.inner.Start(ctx, d.wrap(callback))
+}
+
+func (d *dedupTrigger) StartFrom(ctx context.Context, checkpoint []byte, callback TriggerCallback) error {
+	return startFrom(ctx, d.inner, checkpoint, d.wrap(callback))
+}
+
+func (d *dedupTrigger) wrap(callback TriggerCallback) TriggerCallback {
+	return func(ctx context.Context, event TriggerEvent) error {
+		key := d.keyFn(event)
+		if key == "" {
+			return callback(ctx, event)
+		}
+
+		expiry, first := d.claim(key)
+		if !first {
+			d.logger.DebugContext(ctx, "dropping duplicate event", "dedup_key", key, "trigger_id", event.TriggerID)
+
+			return nil
+		}
+
+		err := callback(ctx, event)
+		if err != nil {
+			d.release(key, expiry)
+		}
+
+		return err
+	}
+}
+
+// claim records key as seen and reports whether it was not already seen
+// within the window.
+func (d *dedupTrigger) claim(key string) (time.Time, bool) {
+	now := time.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if now.Sub(d.lastSweep) >= d.window {
+		for k, exp := range d.seen {
+			if !now.Before(exp) {
+				delete(d.seen, k)
+			}
+		}
+
+		d.lastSweep = now
+	}
+
+	if exp, ok := d.seen[key]; ok && now.Before(exp) {
+		return time.Time{}, false
+	}
+
+	expiry := now.Add(d.window)
+	d.seen[key] = expiry
+
+	return expiry, true
+}
+
+// release forgets key unless it has been claimed again since.
+func (d *dedupTrigger) release(key string, expiry time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.seen[key].Equal(expiry) {
+		delete(d.seen, key)
+	}
+}
+
+func (d *dedupTrigger) Unwrap() Trigger {
+	return d.inner
+}
+
+func (*dedupTrigger) forwardsStart() {}
+
+func (d *dedupTrigger) Stop(ctx context.Context) error {
+	return d.inner.Stop(ctx)
+}
+
+func (d *dedupTrigger) Validate(ctx context.Context) error {
+	return d.inner.Validate(ctx)
+}
@@ -0,0 +1,46 @@
+package interfaces
+
+// FactoryDescriptor aggregates a factory's metadata, e.g. for building a
+// catalog of available actions and triggers. The schema is marshaled as a
+// nested JSON object. Capabilities is only set for factories that implement
+// CapabilityProvider.
+type FactoryDescriptor struct {
+	Kind         FactoryKind    `json:"kind"`
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description"`
+	Schema       map[string]any `json:"schema"`
+	Capabilities Capability     `json:"capabilities"`
+}
+
+// DescribeAction returns the descriptor of an action factory.
+func DescribeAction(f ActionFactory) FactoryDescriptor {
+	return FactoryDescriptor{
+		Kind:         KindAction,
+		ID:           f.ID(),
+		Name:         f.Name(),
+		Description:  f.Description(),
+		Schema:       f.Schema(),
+		Capabilities: declaredCapabilities(f),
+	}
+}
+
+// DescribeTrigger returns the descriptor of a trigger factory.
+func DescribeTrigger(f TriggerFactory) FactoryDescriptor {
+	return FactoryDescriptor{
+		Kind:         KindTrigger,
+		ID:           f.ID(),
+		Name:         f.Name(),
+		Description:  f.Description(),
+		Schema:       f.Schema(),
+		Capabilities: declaredCapabilities(f),
+	}
+}
+
+func declaredCapabilities(f any) Capability {
+	if cp, ok := f.(CapabilityProvider); ok {
+		return cp.Capabilities()
+	}
+
+	return 0
+}
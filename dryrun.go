@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// ErrDryRunUnsupported is returned by ExecuteDryRun for actions that do not
+// implement DryRunner.
+var ErrDryRunUnsupported = errors.New("interfaces: action does not support dry run")
+
+// DryRunner is an optional interface for actions that can simulate their
+// effect without side effects, e.g. an HTTP action that resolves its URL and
+// headers but does not send the request.
+type DryRunner interface {
+	DryRun(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error)
+}
+
+// ExecuteDryRun calls a's DryRun if it implements DryRunner and returns
+// ErrDryRunUnsupported otherwise. The wrappers in this package forward dry
+// runs through their own logic (see Unwrapper).
+func ExecuteDryRun(ctx context.Context, a Action, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	if dr, ok := a.(DryRunner); ok {
+		return dr.DryRun(ctx, executionCtx, logger)
+	}
+
+	return nil, ErrDryRunUnsupported
+}
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrFactoryNotFound is returned, wrapped in a FactoryNotFoundError, when a
+// factory ID is not registered.
+var ErrFactoryNotFound = errors.New("interfaces: factory not found")
+
+// FactoryKind identifies whether a factory produces actions or triggers.
+type FactoryKind string
+
+const (
+	KindAction  FactoryKind = "action"
+	KindTrigger FactoryKind = "trigger"
+)
+
+// FactoryNotFoundError reports a lookup of an unregistered factory ID.
+type FactoryNotFoundError struct {
+	Kind FactoryKind
+	ID   string
+}
+
+func (e *FactoryNotFoundError) Error() string {
+	return fmt.Sprintf("interfaces: %s factory %q not found", e.Kind, e.ID)
+}
+
+func (e *FactoryNotFoundError) Unwrap() error {
+	return ErrFactoryNotFound
+}
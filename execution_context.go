@@ -0,0 +1,80 @@
+package interfaces
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// ErrVariableNotFound is returned, wrapped, by GetVar when the variable is
+// not set.
+var ErrVariableNotFound = errors.New("interfaces: variable not found")
+
+// GetVar returns the workflow variable key from ec.Variables as a T.
+//
+// Numbers are converted to other numeric types when the value fits, so
+// GetVar[int] accepts the float64 values produced by JSON decoding as long as
+// they are whole. Any other mismatch is reported with the key and both types;
+// a missing key yields an error matching ErrVariableNotFound.
+func GetVar[T any](ec models.ExecutionContext, key string) (T, error) {
+	var zero T
+
+	raw, ok := ec.Variables[key]
+	if !ok {
+		return zero, fmt.Errorf("%w: %q", ErrVariableNotFound, key)
+	}
+
+	if v, ok := raw.(T); ok {
+		return v, nil
+	}
+
+	target := reflect.TypeFor[T]()
+
+	if n, ok := asNumber(raw); ok {
+		if v, ok := convertNumber(n, target); ok {
+			return v.Interface().(T), nil
+		}
+	}
+
+	return zero, fmt.Errorf("interfaces: variable %q has type %T, want %s", key, raw, target)
+}
+
+// StepOutput returns the output recorded for stepID in ec.StepResults.
+func StepOutput(ec models.ExecutionContext, stepID string) (any, bool) {
+	out, ok := ec.StepResults[stepID]
+	return out, ok
+}
+
+// convertNumber converts n to the numeric type t if the value fits, requiring
+// a whole number for integer types.
+func convertNumber(n float64, t reflect.Type) (reflect.Value, bool) {
+	v := reflect.New(t).Elem()
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n != math.Trunc(n) || n < math.MinInt64 || n >= math.MaxInt64 || v.OverflowInt(int64(n)) {
+			return reflect.Value{}, false
+		}
+
+		v.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n != math.Trunc(n) || n < 0 || n >= math.MaxUint64 || v.OverflowUint(uint64(n)) {
+			return reflect.Value{}, false
+		}
+
+		v.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		if v.OverflowFloat(n) {
+			return reflect.Value{}, false
+		}
+
+		v.SetFloat(n)
+	default:
+		return reflect.Value{}, false
+	}
+
+	return v, true
+}
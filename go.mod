@@ -2,4 +2,7 @@ module github.com/operion-flow/interfaces
 
 go 1.24.4
 
-require github.com/dukex/operion v0.0.0-20250806035439-128d5eec3325
+require (
+	github.com/dukex/operion v0.0.0-20250806035439-128d5eec3325
+	golang.org/x/time v0.14.0
+)
@@ -0,0 +1,24 @@
+package interfaces
+
+import "context"
+
+// HealthChecker is an optional interface for triggers that can report whether
+// their underlying resources (connections, consumers, schedulers) are still
+// working.
+//
+// HealthCheck returns nil when the trigger is healthy. A non-nil error should
+// describe the failure, e.g. "kafka broker unreachable". The context deadline
+// bounds how long the check may block.
+type HealthChecker interface {
+	HealthCheck(ctx context.Context) error
+}
+
+// CheckHealth runs the health check of the first trigger in t's chain (see
+// TriggerAs) that implements HealthChecker and returns nil if there is none.
+func CheckHealth(ctx context.Context, t Trigger) error {
+	if hc, ok := TriggerAs[HealthChecker](t); ok {
+		return hc.HealthCheck(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// IdempotentAction is an optional interface for actions that can deduplicate
+// side effects (charging a card, sending an email) against their backend.
+//
+// The idempotency key identifies one logical workflow step and is stable
+// across retries of that step, so an action that has already completed the
+// work for a key must not perform it again.
+type IdempotentAction interface {
+	ExecuteIdempotent(ctx context.Context, executionCtx models.ExecutionContext, idempotencyKey string, logger *slog.Logger) (*ActionResult, error)
+}
+
+// ExecuteWithIdempotency calls a's ExecuteIdempotent with key if it implements
+// IdempotentAction. Otherwise it calls Execute and logs a warning that retries
+// of the step may perform its side effects more than once. The wrappers in
+// this package forward the key through their own logic (see Unwrapper).
+func ExecuteWithIdempotency(ctx context.Context, a Action, key string, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	if ia, ok := a.(IdempotentAction); ok {
+		return ia.ExecuteIdempotent(ctx, executionCtx, key, logger)
+	}
+
+	orDefaultLogger(logger).Warn("action does not support idempotency keys; retries may execute it more than once",
+		"idempotency_key", key,
+	)
+
+	return a.Execute(ctx, executionCtx, logger)
+}
@@ -0,0 +1,129 @@
+// Package interfacestest provides helpers for testing implementations of the
+// interfaces package.
+package interfacestest
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/operion-flow/interfaces"
+)
+
+// Timeout bounds how long the helpers in this package wait for a trigger or
+// action to make progress before failing the test.
+var Timeout = 5 * time.Second
+
+// stopGrace is how long AssertDrains waits to make sure Stop keeps blocking
+// while callbacks are in flight.
+const stopGrace = 50 * time.Millisecond
+
+// AssertDrains checks that trigger honors the Stop drain contract. It starts
+// the trigger with a callback that blocks, fires n events, waits until n
+// callbacks are in flight, calls Stop and verifies that Stop does not return
+// until all of them have completed.
+//
+// fire makes the trigger emit its i-th event, e.g. by sending a request to a
+// webhook trigger or calling MockTrigger.Fire. Each call runs in its own
+// goroutine once Start has returned, so fire may block until the callback
+// completes. A nil fire is for triggers that emit at least n events on their
+// own after Start. The trigger must be able to run n callbacks concurrently;
+// use n = 1 for triggers that deliver events one at a time.
+func AssertDrains(t testing.TB, trigger interfaces.Trigger, n int, fire func(ctx context.Context, i int)) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var started, completed atomic.Int64
+
+	entered := make(chan struct{}, n)
+	release := make(chan struct{})
+
+	callback := func(context.Context, interfaces.TriggerEvent) error {
+		if started.Add(1) <= int64(n) {
+			entered <- struct{}{}
+		}
+
+		<-release
+		completed.Add(1)
+
+		return nil
+	}
+
+	startErr := make(chan error, 1)
+
+	go func() { startErr <- trigger.Start(ctx, callback) }()
+
+	timeout := time.After(Timeout)
+
+	if fire != nil {
+		select {
+		case err := <-startErr:
+			if err != nil {
+				t.Fatalf("Start returned error: %v", err)
+			}
+
+			startErr = nil
+		case <-timeout:
+			t.Fatalf("Start did not return within %s", Timeout)
+		}
+
+		for i := range n {
+			go fire(ctx, i)
+		}
+	}
+
+	for inFlight := 0; inFlight < n; {
+		select {
+		case <-entered:
+			inFlight++
+		case err := <-startErr:
+			if err != nil {
+				close(release)
+				t.Fatalf("Start returned error: %v", err)
+			}
+
+			startErr = nil
+		case <-timeout:
+			close(release)
+			t.Fatalf("only %d of %d callbacks started within %s", inFlight, n, Timeout)
+		}
+	}
+
+	stopCtx, stopCancel := context.WithTimeout(context.Background(), Timeout)
+	defer stopCancel()
+
+	stopErr := make(chan error, 1)
+
+	go func() { stopErr <- trigger.Stop(stopCtx) }()
+
+	select {
+	case err := <-stopErr:
+		close(release)
+		t.Fatalf("Stop returned (err=%v) while %d callbacks were still in flight", err, n)
+	case <-time.After(stopGrace):
+	}
+
+	if d, ok := interfaces.TriggerAs[interfaces.Draining](trigger); ok {
+		if inFlight := d.InFlight(); inFlight < n {
+			t.Errorf("InFlight() = %d during Stop, want at least %d", inFlight, n)
+		}
+	}
+
+	close(release)
+
+	select {
+	case err := <-stopErr:
+		if err != nil {
+			t.Errorf("Stop returned error: %v", err)
+		}
+	case <-time.After(Timeout):
+		t.Fatalf("Stop did not return within %s after callbacks completed", Timeout)
+	}
+
+	if s, c := started.Load(), completed.Load(); c != s {
+		t.Errorf("Stop returned with %d of %d callbacks completed", c, s)
+	}
+}
@@ -0,0 +1,227 @@
+package interfacestest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+	"github.com/operion-flow/interfaces"
+)
+
+// ErrNotStarted is returned by MockTrigger.Fire when the trigger has not been
+// started or has been stopped.
+var ErrNotStarted = errors.New("interfacestest: trigger not started")
+
+// MockAction is an Action whose behavior is set through its function fields.
+// A nil ExecuteFunc returns an empty result and a nil ValidateFunc succeeds.
+// Every Execute call is recorded. It is safe for concurrent use.
+type MockAction struct {
+	ExecuteFunc  interfaces.ExecuteFunc
+	ValidateFunc func(ctx context.Context) error
+
+	mu    sync.Mutex
+	calls []models.ExecutionContext
+}
+
+func (m *MockAction) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*interfaces.ActionResult, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, executionCtx)
+	m.mu.Unlock()
+
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, executionCtx, logger)
+	}
+
+	return &interfaces.ActionResult{}, nil
+}
+
+func (m *MockAction) Validate(ctx context.Context) error {
+	if m.ValidateFunc != nil {
+		return m.ValidateFunc(ctx)
+	}
+
+	return nil
+}
+
+// Calls returns the execution contexts of all Execute calls so far, in order.
+func (m *MockAction) Calls() []models.ExecutionContext {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.calls)
+}
+
+// MockTrigger is a Trigger that emits events only when the test calls Fire.
+// The optional function fields run in addition to the mock's own bookkeeping.
+// Stop waits for callbacks in flight to return, as the Trigger contract
+// requires, and the mock implements interfaces.Draining. It is safe for
+// concurrent use.
+type MockTrigger struct {
+	// TriggerID is set as the TriggerID of fired events.
+	TriggerID    string
+	StartFunc    func(ctx context.Context) error
+	StopFunc     func(ctx context.Context) error
+	ValidateFunc func(ctx context.Context) error
+
+	mu       sync.Mutex
+	callback interfaces.TriggerCallback
+	inFlight int
+	idle     chan struct{} // closed when inFlight drops to 0; nil if nobody waits
+}
+
+func (m *MockTrigger) Start(ctx context.Context, callback interfaces.TriggerCallback) error {
+	if m.StartFunc != nil {
+		if err := m.StartFunc(ctx); err != nil {
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	m.callback = callback
+	m.mu.Unlock()
+
+	return nil
+}
+
+func (m *MockTrigger) Stop(ctx context.Context) error {
+	m.mu.Lock()
+	m.callback = nil
+
+	var idle chan struct{}
+	if m.inFlight > 0 {
+		if m.idle == nil {
+			m.idle = make(chan struct{})
+		}
+
+		idle = m.idle
+	}
+	m.mu.Unlock()
+
+	if idle != nil {
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if m.StopFunc != nil {
+		return m.StopFunc(ctx)
+	}
+
+	return nil
+}
+
+func (m *MockTrigger) Validate(ctx context.Context) error {
+	if m.ValidateFunc != nil {
+		return m.ValidateFunc(ctx)
+	}
+
+	return nil
+}
+
+// Started reports whether the trigger has been started and not stopped.
+func (m *MockTrigger) Started() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.callback != nil
+}
+
+// InFlight returns the number of callbacks that are currently running.
+func (m *MockTrigger) InFlight() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.inFlight
+}
+
+// Fire invokes the callback passed to Start with an event carrying data and
+// returns the callback's error. It returns ErrNotStarted if the trigger is not
+// running.
+func (m *MockTrigger) Fire(ctx context.Context, data map[string]any) error {
+	return m.FireEvent(ctx, interfaces.TriggerEvent{
+		TriggerID: m.TriggerID,
+		EmittedAt: time.Now(),
+		Data:      data,
+	})
+}
+
+// FireEvent is like Fire but delivers event as is.
+func (m *MockTrigger) FireEvent(ctx context.Context, event interfaces.TriggerEvent) error {
+	m.mu.Lock()
+	callback := m.callback
+	if callback != nil {
+		m.inFlight++
+	}
+	m.mu.Unlock()
+
+	if callback == nil {
+		return ErrNotStarted
+	}
+
+	defer m.done()
+
+	return callback(ctx, event)
+}
+
+func (m *MockTrigger) done() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.inFlight--
+	if m.inFlight == 0 && m.idle != nil {
+		close(m.idle)
+		m.idle = nil
+	}
+}
+
+// MockActionFactory is an ActionFactory with configurable metadata. A nil
+// CreateFunc creates a new MockAction.
+type MockActionFactory struct {
+	FactoryID          string
+	FactoryName        string
+	FactoryDescription string
+	FactorySchema      map[string]any
+	CreateFunc         func(ctx context.Context, config map[string]any) (interfaces.Action, error)
+}
+
+func (f *MockActionFactory) Create(ctx context.Context, config map[string]any) (interfaces.Action, error) {
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, config)
+	}
+
+	return &MockAction{}, nil
+}
+
+func (f *MockActionFactory) ID() string             { return f.FactoryID }
+func (f *MockActionFactory) Name() string           { return f.FactoryName }
+func (f *MockActionFactory) Description() string    { return f.FactoryDescription }
+func (f *MockActionFactory) Schema() map[string]any { return f.FactorySchema }
+
+// MockTriggerFactory is a TriggerFactory with configurable metadata. A nil
+// CreateFunc creates a new MockTrigger whose TriggerID is the factory's ID.
+type MockTriggerFactory struct {
+	FactoryID          string
+	FactoryName        string
+	FactoryDescription string
+	FactorySchema      map[string]any
+	CreateFunc         func(ctx context.Context, config map[string]any, logger *slog.Logger) (interfaces.Trigger, error)
+}
+
+func (f *MockTriggerFactory) Create(ctx context.Context, config map[string]any, logger *slog.Logger) (interfaces.Trigger, error) {
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, config, logger)
+	}
+
+	return &MockTrigger{TriggerID: f.FactoryID}, nil
+}
+
+func (f *MockTriggerFactory) ID() string             { return f.FactoryID }
+func (f *MockTriggerFactory) Name() string           { return f.FactoryName }
+func (f *MockTriggerFactory) Description() string    { return f.FactoryDescription }
+func (f *MockTriggerFactory) Schema() map[string]any { return f.FactorySchema }
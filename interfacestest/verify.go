@@ -0,0 +1,76 @@
+package interfacestest
+
+import (
+	"testing"
+
+	"github.com/operion-flow/interfaces"
+)
+
+// VerifyFactory checks that f's Schema agrees with what Create and Validate
+// accept. It asserts that:
+//
+//   - ID is non-empty and returns the same value on every call;
+//   - Schema accepts every config in validConfigs and rejects every config in
+//     invalidConfigs, according to interfaces.ValidateConfig;
+//   - Create succeeds, and the created action validates, for every valid
+//     config;
+//   - for every invalid config, Create fails or the created action does not
+//     validate.
+//
+// Actions created along the way are closed with interfaces.CloseAction.
+func VerifyFactory(t testing.TB, f interfaces.ActionFactory, validConfigs []map[string]any, invalidConfigs []map[string]any) {
+	t.Helper()
+
+	ctx := t.Context()
+
+	id := f.ID()
+	if id == "" {
+		t.Errorf("ID() is empty")
+	}
+
+	if again := f.ID(); again != id {
+		t.Errorf("ID() is not stable: got %q, then %q", id, again)
+	}
+
+	schema := f.Schema()
+
+	for i, config := range validConfigs {
+		if err := interfaces.ValidateConfig(schema, config); err != nil {
+			t.Errorf("valid config %d: rejected by schema: %v", i, err)
+		}
+
+		action, err := f.Create(ctx, config)
+		if err != nil {
+			t.Errorf("valid config %d: Create failed: %v", i, err)
+
+			continue
+		}
+
+		if err := action.Validate(ctx); err != nil {
+			t.Errorf("valid config %d: Validate failed: %v", i, err)
+		}
+
+		if err := interfaces.CloseAction(action); err != nil {
+			t.Errorf("valid config %d: Close failed: %v", i, err)
+		}
+	}
+
+	for i, config := range invalidConfigs {
+		if err := interfaces.ValidateConfig(schema, config); err == nil {
+			t.Errorf("invalid config %d: accepted by schema", i)
+		}
+
+		action, err := f.Create(ctx, config)
+		if err != nil {
+			continue
+		}
+
+		if err := action.Validate(ctx); err == nil {
+			t.Errorf("invalid config %d: accepted by both Create and Validate", i)
+		}
+
+		if err := interfaces.CloseAction(action); err != nil {
+			t.Errorf("invalid config %d: Close failed: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// ResultMapper transforms the result of an action.
+type ResultMapper func(result *ActionResult) (*ActionResult, error)
+
+// NewMappedAction returns an Action that runs inner and passes its result
+// through mapper, e.g. to reshape the output for a downstream step. Errors
+// from inner are returned without calling mapper. Validate delegates to
+// inner. It returns an error if inner or mapper is nil.
+func NewMappedAction(inner Action, mapper ResultMapper) (Action, error) {
+	if inner == nil {
+		return nil, errors.New("interfaces: mapped action requires an inner action")
+	}
+
+	if mapper == nil {
+		return nil, errors.New("interfaces: mapped action requires a mapper")
+	}
+
+	return wrapAction(inner, func(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger, call ExecuteFunc) (*ActionResult, error) {
+		result, err := call(ctx, executionCtx, logger)
+		if err != nil {
+			return nil, err
+		}
+
+		return mapper(result)
+	}), nil
+}
@@ -0,0 +1,214 @@
+package interfaces
+
+import (
+	"context"
+	"log/slog"
+	"math/bits"
+	"sync/atomic"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// ActionMetrics is a snapshot of an action's runtime counters.
+type ActionMetrics struct {
+	Executions uint64         `json:"executions"`
+	Errors     uint64         `json:"errors"`
+	Latency    LatencySummary `json:"latency"`
+}
+
+// LatencySummary summarizes the duration of an action's executions.
+// Percentiles are estimates with a relative error below 25%.
+type LatencySummary struct {
+	Mean time.Duration `json:"mean"`
+	P50  time.Duration `json:"p50"`
+	P99  time.Duration `json:"p99"`
+	Max  time.Duration `json:"max"`
+}
+
+// MetricsReporter is an optional interface for actions that expose runtime
+// counters, e.g. for an admin endpoint. Metrics must be safe to call
+// concurrently with Execute.
+type MetricsReporter interface {
+	Metrics() ActionMetrics
+}
+
+// MetricsOf returns the metrics of the first action in a's chain (see
+// ActionAs) that implements MetricsReporter, so the counters of
+// MetricsMiddleware stay reachable when other middlewares wrap it. ok is false
+// if there is none.
+func MetricsOf(a Action) (metrics ActionMetrics, ok bool) {
+	mr, ok := ActionAs[MetricsReporter](a)
+	if !ok {
+		return ActionMetrics{}, false
+	}
+
+	return mr.Metrics(), true
+}
+
+// MetricsMiddleware records the executions, errors and latency of the
+// wrapped action. The returned action implements MetricsReporter regardless
+// of whether the wrapped one does; use MetricsOf to read it from behind other
+// wrappers. Recording uses atomic counters only, so it adds no lock
+// contention under concurrent execution.
+func MetricsMiddleware() ActionMiddleware {
+	return func(next Action) Action {
+		return &metricsAction{inner: next}
+	}
+}
+
+type metricsAction struct {
+	inner Action
+
+	executions atomic.Uint64
+	errors     atomic.Uint64
+	latency    latencyHistogram
+}
+
+func (m *metricsAction) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	return m.run(ctx, executionCtx, logger, m.inner.Execute)
+}
+
+func (m *metricsAction) DryRun(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	return forwardDryRun(ctx, m.inner, executionCtx, logger, m.run)
+}
+
+func (m *metricsAction) ExecuteIdempotent(ctx context.Context, executionCtx models.ExecutionContext, key string, logger *slog.Logger) (*ActionResult, error) {
+	return forwardIdempotent(ctx, m.inner, key, executionCtx, logger, m.run)
+}
+
+func (m *metricsAction) run(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger, call ExecuteFunc) (*ActionResult, error) {
+	start := time.Now()
+
+	result, err := call(ctx, executionCtx, logger)
+
+	m.latency.record(time.Since(start))
+	m.executions.Add(1)
+
+	if err != nil {
+		m.errors.Add(1)
+	}
+
+	return result, err
+}
+
+func (m *metricsAction) Validate(ctx context.Context) error {
+	return m.inner.Validate(ctx)
+}
+
+func (m *metricsAction) Close() error {
+	return CloseAction(m.inner)
+}
+
+func (m *metricsAction) Unwrap() Action {
+	return m.inner
+}
+
+func (*metricsAction) forwardsCalls() {}
+
+func (m *metricsAction) Metrics() ActionMetrics {
+	return ActionMetrics{
+		Executions: m.executions.Load(),
+		Errors:     m.errors.Load(),
+		Latency:    m.latency.summary(),
+	}
+}
+
+// latencySubBits is the number of mantissa bits used to split each power of
+// two into sub-buckets, bounding the relative error of percentiles.
+const latencySubBits = 2
+
+const latencyBuckets = 64 << latencySubBits
+
+// latencyHistogram is a log-linear histogram of durations in nanoseconds
+// whose buckets are updated with atomic adds.
+type latencyHistogram struct {
+	buckets [latencyBuckets]atomic.Uint64
+	count   atomic.Uint64
+	sum     atomic.Uint64
+	max     atomic.Int64
+}
+
+func (h *latencyHistogram) record(d time.Duration) {
+	ns := max(int64(d), 0)
+
+	h.buckets[latencyBucket(uint64(ns))].Add(1)
+	h.count.Add(1)
+	h.sum.Add(uint64(ns))
+
+	for {
+		cur := h.max.Load()
+		if ns <= cur || h.max.CompareAndSwap(cur, ns) {
+			break
+		}
+	}
+}
+
+func (h *latencyHistogram) summary() LatencySummary {
+	var counts [latencyBuckets]uint64
+
+	total := uint64(0)
+	for i := range h.buckets {
+		counts[i] = h.buckets[i].Load()
+		total += counts[i]
+	}
+
+	if total == 0 {
+		return LatencySummary{}
+	}
+
+	maxLatency := time.Duration(h.max.Load())
+
+	return LatencySummary{
+		Mean: time.Duration(h.sum.Load() / max(h.count.Load(), 1)),
+		P50:  min(percentile(&counts, total, 0.50), maxLatency),
+		P99:  min(percentile(&counts, total, 0.99), maxLatency),
+		Max:  maxLatency,
+	}
+}
+
+// percentile returns the upper bound of the bucket holding the q-th quantile.
+func percentile(counts *[latencyBuckets]uint64, total uint64, q float64) time.Duration {
+	rank := uint64(q * float64(total))
+	if rank == 0 {
+		rank = 1
+	}
+
+	seen := uint64(0)
+	for i, c := range counts {
+		seen += c
+		if seen >= rank {
+			return time.Duration(latencyBucketUpper(i))
+		}
+	}
+
+	return time.Duration(latencyBucketUpper(latencyBuckets - 1))
+}
+
+// latencyBucket maps ns to its bucket. Values below 2^latencySubBits get a
+// bucket each; larger values are grouped by their highest set bit and the
+// latencySubBits bits below it.
+func latencyBucket(ns uint64) int {
+	if ns < 1<<latencySubBits {
+		return int(ns)
+	}
+
+	exp := bits.Len64(ns) - 1
+	sub := (ns >> (exp - latencySubBits)) & (1<<latencySubBits - 1)
+
+	return (exp-latencySubBits+1)<<latencySubBits + int(sub)
+}
+
+// latencyBucketUpper returns the largest value that maps to bucket i.
+func latencyBucketUpper(i int) uint64 {
+	if i < 1<<latencySubBits {
+		return uint64(i)
+	}
+
+	exp := i>>latencySubBits + latencySubBits - 1
+	sub := uint64(i & (1<<latencySubBits - 1))
+	width := uint64(1) << (exp - latencySubBits)
+	lower := uint64(1)<<exp + sub*width
+
+	return lower + width - 1
+}
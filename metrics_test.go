@@ -0,0 +1,127 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+func TestLatencyBucketBounds(t *testing.T) {
+	last := latencyBucket(math.MaxUint64)
+	if last >= latencyBuckets {
+		t.Fatalf("latencyBucket(MaxUint64) = %d, want < %d", last, latencyBuckets)
+	}
+
+	if got := latencyBucketUpper(last); got != math.MaxUint64 {
+		t.Fatalf("latencyBucketUpper(%d) = %d, want MaxUint64", last, got)
+	}
+
+	lower := uint64(0)
+	for i := 0; i <= last; i++ {
+		upper := latencyBucketUpper(i)
+
+		if got := latencyBucket(lower); got != i {
+			t.Fatalf("latencyBucket(%d) = %d, want %d (lower bound)", lower, got, i)
+		}
+
+		if got := latencyBucket(upper); got != i {
+			t.Fatalf("latencyBucket(%d) = %d, want %d (upper bound)", upper, got, i)
+		}
+
+		if i >= 1<<latencySubBits && float64(upper-lower+1)/float64(lower) > 0.25 {
+			t.Errorf("bucket %d spans [%d, %d], wider than 25%% of its lower bound", i, lower, upper)
+		}
+
+		lower = upper + 1
+	}
+}
+
+func TestLatencyBucketSmallValuesExact(t *testing.T) {
+	for ns := uint64(0); ns < 1<<latencySubBits; ns++ {
+		if got := latencyBucketUpper(latencyBucket(ns)); got != ns {
+			t.Errorf("upper bound of the bucket of %d = %d, want %d", ns, got, ns)
+		}
+	}
+}
+
+func TestLatencyHistogramSummary(t *testing.T) {
+	var h latencyHistogram
+
+	if got := h.summary(); got != (LatencySummary{}) {
+		t.Fatalf("empty summary = %+v, want zero", got)
+	}
+
+	for i := 1; i <= 100; i++ {
+		h.record(time.Duration(i) * time.Millisecond)
+	}
+
+	got := h.summary()
+
+	if got.Max != 100*time.Millisecond {
+		t.Errorf("Max = %v, want 100ms", got.Max)
+	}
+
+	if want := 50500 * time.Microsecond; got.Mean != want {
+		t.Errorf("Mean = %v, want %v", got.Mean, want)
+	}
+
+	for _, p := range []struct {
+		name      string
+		got, want time.Duration
+	}{
+		{"P50", got.P50, 50 * time.Millisecond},
+		{"P99", got.P99, 99 * time.Millisecond},
+	} {
+		if p.got < p.want || float64(p.got) > 1.25*float64(p.want) {
+			t.Errorf("%s = %v, want within 25%% above %v", p.name, p.got, p.want)
+		}
+	}
+}
+
+func TestLatencyPercentileCappedAtMax(t *testing.T) {
+	var h latencyHistogram
+
+	h.record(5 * time.Millisecond)
+
+	if got := h.summary(); got.P50 != got.Max || got.P99 != got.Max {
+		t.Errorf("summary = %+v, want percentiles equal to Max", got)
+	}
+}
+
+func TestMetricsOfThroughChain(t *testing.T) {
+	fail := false
+	inner := funcAction(func(context.Context, models.ExecutionContext, *slog.Logger) (*ActionResult, error) {
+		if fail {
+			return nil, errTest
+		}
+
+		return &ActionResult{}, nil
+	})
+
+	a := Chain(inner, LoggingMiddleware(slog.New(slog.DiscardHandler)), MetricsMiddleware(), RecoverMiddleware())
+
+	_ = execute(a)
+	fail = true
+
+	if err := execute(a); !errors.Is(err, errTest) {
+		t.Fatalf("err = %v, want errTest", err)
+	}
+
+	m, ok := MetricsOf(a)
+	if !ok {
+		t.Fatal("MetricsOf found no MetricsReporter in the chain")
+	}
+
+	if m.Executions != 2 || m.Errors != 1 {
+		t.Errorf("metrics = %+v, want 2 executions and 1 error", m)
+	}
+
+	if _, ok := MetricsOf(inner); ok {
+		t.Error("MetricsOf reported metrics for an unwrapped action")
+	}
+}
@@ -0,0 +1,123 @@
+package interfaces
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// ActionMiddleware decorates an Action with cross-cutting behavior such as
+// logging, metrics or retries.
+type ActionMiddleware func(Action) Action
+
+// Chain applies mws to a in order, so the first middleware wraps a directly
+// and the last one is the outermost.
+func Chain(a Action, mws ...ActionMiddleware) Action {
+	for _, mw := range mws {
+		a = mw(a)
+	}
+
+	return a
+}
+
+// ExecuteFunc has the signature of Action.Execute.
+type ExecuteFunc func(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error)
+
+// wrappedAction runs around the Execute, DryRun and ExecuteIdempotent of an
+// inner action and delegates Validate and Close to it. Middlewares use it so
+// they only have to describe their logic once.
+type wrappedAction struct {
+	inner  Action
+	around aroundFunc
+	// plainDryRun makes DryRun skip around, for wrappers whose logic must
+	// not see dry runs.
+	plainDryRun bool
+}
+
+func wrapAction(inner Action, around aroundFunc) Action {
+	return wrappedAction{inner: inner, around: around}
+}
+
+func (a wrappedAction) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	return a.around(ctx, executionCtx, logger, a.inner.Execute)
+}
+
+func (a wrappedAction) DryRun(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	if a.plainDryRun {
+		return ExecuteDryRun(ctx, a.inner, executionCtx, logger)
+	}
+
+	return forwardDryRun(ctx, a.inner, executionCtx, logger, a.around)
+}
+
+func (a wrappedAction) ExecuteIdempotent(ctx context.Context, executionCtx models.ExecutionContext, key string, logger *slog.Logger) (*ActionResult, error) {
+	return forwardIdempotent(ctx, a.inner, key, executionCtx, logger, a.around)
+}
+
+func (a wrappedAction) Validate(ctx context.Context) error {
+	return a.inner.Validate(ctx)
+}
+
+func (a wrappedAction) Close() error {
+	return CloseAction(a.inner)
+}
+
+func (a wrappedAction) Unwrap() Action {
+	return a.inner
+}
+
+func (wrappedAction) forwardsCalls() {}
+
+// LoggingMiddleware logs the start and finish of every Execute call, and its
+// error if it fails. A nil logger uses the logger passed to Execute, or
+// slog.Default() if that is nil too.
+func LoggingMiddleware(logger *slog.Logger) ActionMiddleware {
+	return func(next Action) Action {
+		return wrapAction(next, func(ctx context.Context, executionCtx models.ExecutionContext, execLogger *slog.Logger, call ExecuteFunc) (*ActionResult, error) {
+			log := logger
+			if log == nil {
+				log = orDefaultLogger(execLogger)
+			}
+
+			log = log.With("execution_id", executionCtx.ID, "workflow_id", executionCtx.WorkflowID)
+			if stepID, ok := StepIDFromContext(ctx); ok {
+				log = log.With("step_id", stepID)
+			}
+			log.InfoContext(ctx, "action started")
+
+			start := time.Now()
+
+			result, err := call(ctx, executionCtx, execLogger)
+			if err != nil {
+				log.ErrorContext(ctx, "action failed", "duration", time.Since(start), "error", err)
+
+				return result, err
+			}
+
+			log.InfoContext(ctx, "action finished", "duration", time.Since(start))
+
+			return result, nil
+		})
+	}
+}
+
+// orDefaultLogger returns logger, or slog.Default() if it is nil.
+func orDefaultLogger(logger *slog.Logger) *slog.Logger {
+	if logger == nil {
+		return slog.Default()
+	}
+
+	return logger
+}
+
+// RecoverMiddleware converts a panic inside Execute into a *PanicError, so a
+// misbehaving action fails its execution instead of crashing the process.
+func RecoverMiddleware() ActionMiddleware {
+	return func(next Action) Action {
+		return wrapAction(next, func(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger, call ExecuteFunc) (*ActionResult, error) {
+			return safeCall(ctx, call, executionCtx, logger)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package interfaces
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"sync"
+)
+
+// NewMultiTrigger returns a Trigger that merges the events of triggers into a
+// single callback stream.
+//
+// Start starts each trigger in order with the same callback. If one fails to
+// start, the triggers already started are stopped before the error is
+// returned. Stop stops every trigger and Validate validates every trigger;
+// both report all failures.
+//
+// The returned trigger also aggregates the optional interfaces of triggers:
+// it is ready once all of them are, healthy if all of them are, reports the
+// sum of their in-flight callbacks and pauses all of them, or returns
+// ErrPauseUnsupported without pausing any if one cannot pause. Its StartFrom
+// resumes each resumable trigger from its own part of a combined checkpoint;
+// since the parts are acknowledged independently, a saved checkpoint may lag
+// behind for some triggers, which then redeliver events after a restart.
+func NewMultiTrigger(triggers ...Trigger) Trigger {
+	return &multiTrigger{triggers: triggers}
+}
+
+type multiTrigger struct {
+	triggers []Trigger
+
+	readyOnce sync.Once
+	ready     chan struct{}
+
+	mu    sync.Mutex
+	acked map[string][]byte // trigger index -> last acknowledged checkpoint
+}
+
+func (m *multiTrigger) Start(ctx context.Context, callback TriggerCallback) error {
+	return m.start(ctx, func(_ int, t Trigger) error {
+		return t.Start(ctx, callback)
+	})
+}
+
+func (m *multiTrigger) StartFrom(ctx context.Context, checkpoint []byte, callback TriggerCallback) error {
+	parts := map[string][]byte{}
+	if len(checkpoint) > 0 {
+		if err := json.Unmarshal(checkpoint, &parts); err != nil {
+			return fmt.Errorf("interfaces: decode multi-trigger checkpoint: %w", err)
+		}
+	}
+
+	m.mu.Lock()
+	m.acked = maps.Clone(parts)
+	m.mu.Unlock()
+
+	return m.start(ctx, func(i int, t Trigger) error {
+		key := strconv.Itoa(i)
+
+		return startFrom(ctx, t, parts[key], m.checkpointing(key, callback))
+	})
+}
+
+// checkpointing replaces the checkpoint of the events of the trigger at key
+// with a combined checkpoint, which is recorded as acknowledged once callback
+// returns nil.
+func (m *multiTrigger) checkpointing(key string, callback TriggerCallback) TriggerCallback {
+	return func(ctx context.Context, event TriggerEvent) error {
+		part := event.Checkpoint
+		if part == nil {
+			return callback(ctx, event)
+		}
+
+		m.mu.Lock()
+		combined := maps.Clone(m.acked)
+		combined[key] = part
+		m.mu.Unlock()
+
+		encoded, err := json.Marshal(combined)
+		if err != nil {
+			return fmt.Errorf("interfaces: encode multi-trigger checkpoint: %w", err)
+		}
+
+		event.Checkpoint = encoded
+
+		if err := callback(ctx, event); err != nil {
+			return err
+		}
+
+		m.mu.Lock()
+		m.acked[key] = part
+		m.mu.Unlock()
+
+		return nil
+	}
+}
+
+func (m *multiTrigger) start(ctx context.Context, start func(i int, t Trigger) error) error {
+	for i, t := range m.triggers {
+		if err := start(i, t); err != nil {
+			startErr := fmt.Errorf("interfaces: trigger %d failed to start: %w", i, err)
+
+			return errors.Join(startErr, stopAll(ctx, m.triggers[:i]))
+		}
+	}
+
+	return nil
+}
+
+func (m *multiTrigger) Stop(ctx context.Context) error {
+	return stopAll(ctx, m.triggers)
+}
+
+func (m *multiTrigger) Validate(ctx context.Context) error {
+	var errs []error
+
+	for i, t := range m.triggers {
+		if err := t.Validate(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("interfaces: trigger %d: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m *multiTrigger) Ready() <-chan struct{} {
+	m.readyOnce.Do(func() {
+		m.ready = make(chan struct{})
+
+		go func() {
+			for _, t := range m.triggers {
+				if rn, ok := TriggerAs[ReadyNotifier](t); ok {
+					<-rn.Ready()
+				}
+			}
+
+			close(m.ready)
+		}()
+	})
+
+	return m.ready
+}
+
+func (m *multiTrigger) HealthCheck(ctx context.Context) error {
+	var errs []error
+
+	for i, t := range m.triggers {
+		if err := CheckHealth(ctx, t); err != nil {
+			errs = append(errs, fmt.Errorf("interfaces: trigger %d: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m *multiTrigger) InFlight() int {
+	n := 0
+
+	for _, t := range m.triggers {
+		if d, ok := TriggerAs[Draining](t); ok {
+			n += d.InFlight()
+		}
+	}
+
+	return n
+}
+
+func (m *multiTrigger) Pause(ctx context.Context) error {
+	return m.setPaused(ctx, true)
+}
+
+func (m *multiTrigger) Resume(ctx context.Context) error {
+	return m.setPaused(ctx, false)
+}
+
+func (m *multiTrigger) setPaused(ctx context.Context, paused bool) error {
+	for _, t := range m.triggers {
+		if _, ok := TriggerAs[Pausable](t); !ok {
+			return ErrPauseUnsupported
+		}
+	}
+
+	var errs []error
+
+	for i, t := range m.triggers {
+		if err := SetPaused(ctx, t, paused); err != nil {
+			errs = append(errs, fmt.Errorf("interfaces: trigger %d: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// PauseMode returns PauseBuffer if every trigger buffers while paused and
+// PauseDrop otherwise.
+func (m *multiTrigger) PauseMode() PauseMode {
+	for _, t := range m.triggers {
+		if p, ok := TriggerAs[Pausable](t); !ok || p.PauseMode() != PauseBuffer {
+			return PauseDrop
+		}
+	}
+
+	return PauseBuffer
+}
+
+func stopAll(ctx context.Context, triggers []Trigger) error {
+	var errs []error
+
+	for i, t := range triggers {
+		if err := t.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("interfaces: trigger %d failed to stop: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,119 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// ExecutionObserver is notified around every Execute call, e.g. to record
+// spans and metrics without each action importing a tracing library.
+//
+// OnStart returns the context the action is executed with, so an observer can
+// attach a span that the action inherits. OnFinish receives that context along
+// with Execute's result and error; it is also called, with a *PanicError, when
+// Execute panics.
+type ExecutionObserver interface {
+	OnStart(ctx context.Context, actionID string) context.Context
+	OnFinish(ctx context.Context, actionID string, result *ActionResult, err error)
+}
+
+// NoopObserver is an ExecutionObserver that does nothing.
+type NoopObserver struct{}
+
+func (NoopObserver) OnStart(ctx context.Context, _ string) context.Context {
+	return ctx
+}
+
+func (NoopObserver) OnFinish(context.Context, string, *ActionResult, error) {}
+
+// MultiObserver returns an ExecutionObserver that notifies each of observers
+// in order. The context returned by one observer's OnStart is passed to the
+// next.
+func MultiObserver(observers ...ExecutionObserver) ExecutionObserver {
+	return multiObserver(observers)
+}
+
+type multiObserver []ExecutionObserver
+
+func (m multiObserver) OnStart(ctx context.Context, actionID string) context.Context {
+	for _, obs := range m {
+		ctx = obs.OnStart(ctx, actionID)
+	}
+
+	return ctx
+}
+
+func (m multiObserver) OnFinish(ctx context.Context, actionID string, result *ActionResult, err error) {
+	for _, obs := range m {
+		obs.OnFinish(ctx, actionID, result, err)
+	}
+}
+
+type observedAction struct {
+	inner    Action
+	actionID string
+	observer ExecutionObserver
+}
+
+func (a observedAction) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	return a.run(ctx, executionCtx, logger, a.inner.Execute)
+}
+
+func (a observedAction) DryRun(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	return forwardDryRun(ctx, a.inner, executionCtx, logger, a.run)
+}
+
+func (a observedAction) ExecuteIdempotent(ctx context.Context, executionCtx models.ExecutionContext, key string, logger *slog.Logger) (*ActionResult, error) {
+	return forwardIdempotent(ctx, a.inner, key, executionCtx, logger, a.run)
+}
+
+func (a observedAction) run(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger, call ExecuteFunc) (*ActionResult, error) {
+	ctx = a.observer.OnStart(ctx, a.actionID)
+
+	defer func() {
+		if r := recover(); r != nil {
+			panicErr := a.withActionID(recoveredPanic(r))
+			// Let the observer close whatever OnStart opened before the
+			// panic continues up the stack.
+			a.observer.OnFinish(ctx, a.actionID, nil, panicErr)
+			panic(panicErr)
+		}
+	}()
+
+	result, err := call(ctx, executionCtx, logger)
+
+	var panicErr *PanicError
+	if errors.As(err, &panicErr) {
+		a.withActionID(panicErr)
+	}
+
+	a.observer.OnFinish(ctx, a.actionID, result, err)
+
+	return result, err
+}
+
+// withActionID sets e's ActionID to the action's ID unless it is already set.
+func (a observedAction) withActionID(e *PanicError) *PanicError {
+	if e.ActionID == "" {
+		e.ActionID = a.actionID
+	}
+
+	return e
+}
+
+func (a observedAction) Validate(ctx context.Context) error {
+	return a.inner.Validate(ctx)
+}
+
+func (a observedAction) Close() error {
+	return CloseAction(a.inner)
+}
+
+func (a observedAction) Unwrap() Action {
+	return a.inner
+}
+
+func (observedAction) forwardsCalls() {}
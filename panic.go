@@ -0,0 +1,74 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// PanicError reports a panic recovered from a plugin.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+	// ActionID is the ID of the factory that created the panicking action.
+	// Actions created by Registry.CreateAction have it set by the registry;
+	// it is empty for panics recovered elsewhere, such as SafeStart.
+	ActionID string
+}
+
+func (e *PanicError) Error() string {
+	if e.ActionID == "" {
+		return fmt.Sprintf("interfaces: panic: %v", e.Value)
+	}
+
+	return fmt.Sprintf("interfaces: action %q panicked: %v", e.ActionID, e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// recoveredPanic converts the recovered value r into a *PanicError. A value
+// that already is one, re-raised by a wrapper that annotated it, is returned
+// as is.
+func recoveredPanic(r any) *PanicError {
+	if e, ok := r.(*PanicError); ok {
+		return e
+	}
+
+	return &PanicError{Value: r, Stack: debug.Stack()}
+}
+
+// SafeExecute calls a's Execute and converts a panic into a *PanicError.
+func SafeExecute(ctx context.Context, a Action, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	return safeCall(ctx, a.Execute, executionCtx, logger)
+}
+
+func safeCall(ctx context.Context, call ExecuteFunc, executionCtx models.ExecutionContext, logger *slog.Logger) (result *ActionResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, recoveredPanic(r)
+		}
+	}()
+
+	return call(ctx, executionCtx, logger)
+}
+
+// SafeStart calls t's Start and converts a panic into a *PanicError. Panics in
+// goroutines spawned by Start cannot be recovered here.
+func SafeStart(ctx context.Context, t Trigger, callback TriggerCallback) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredPanic(r)
+		}
+	}()
+
+	return t.Start(ctx, callback)
+}
@@ -0,0 +1,123 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// ParallelAction runs its actions concurrently. On success its result's
+// Output is a []*ActionResult holding each action's result at the action's
+// index. Cancelling the parent context cancels every action. A panicking
+// action fails with a *PanicError instead of crashing the process.
+type ParallelAction struct {
+	Actions []Action
+	// FailFast makes the first failure cancel the remaining actions and
+	// return immediately. Otherwise all actions run to completion and every
+	// failure is reported.
+	FailFast bool
+}
+
+// NewParallel returns an Action that runs actions concurrently and waits for
+// all of them. Use a ParallelAction literal to enable FailFast.
+func NewParallel(actions ...Action) Action {
+	return &ParallelAction{Actions: actions}
+}
+
+// ParallelError reports the failures of a ParallelAction. Errors[i] is the
+// error of action i, nil if it succeeded or had not finished; Results[i] is
+// its result, nil if it failed or had not finished.
+type ParallelError struct {
+	Errors  []error
+	Results []*ActionResult
+}
+
+func (e *ParallelError) Error() string {
+	var msgs []string
+
+	for i, err := range e.Errors {
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("action %d: %v", i, err))
+		}
+	}
+
+	return "interfaces: parallel execution failed: " + strings.Join(msgs, "; ")
+}
+
+func (e *ParallelError) Unwrap() []error {
+	var errs []error
+
+	for _, err := range e.Errors {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+type indexedOutcome struct {
+	index int
+	executeOutcome
+}
+
+func (p *ParallelAction) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	outcomes := make(chan indexedOutcome, len(p.Actions))
+
+	for i, action := range p.Actions {
+		go func() {
+			// A panic on this goroutine could not be recovered by the
+			// caller, so it is recorded as the child's *PanicError.
+			result, err := SafeExecute(ctx, action, executionCtx, logger)
+			outcomes <- indexedOutcome{index: i, executeOutcome: executeOutcome{result: result, err: err}}
+		}()
+	}
+
+	results := make([]*ActionResult, len(p.Actions))
+	errs := make([]error, len(p.Actions))
+	failed := false
+
+	for range p.Actions {
+		out := <-outcomes
+		if out.err != nil {
+			errs[out.index] = out.err
+			failed = true
+
+			if p.FailFast {
+				break
+			}
+
+			continue
+		}
+
+		results[out.index] = out.result
+	}
+
+	if failed {
+		return nil, &ParallelError{Errors: errs, Results: results}
+	}
+
+	return &ActionResult{Output: results}, nil
+}
+
+// Validate validates every action and returns the first failure.
+func (p *ParallelAction) Validate(ctx context.Context) error {
+	for i, action := range p.Actions {
+		if err := action.Validate(ctx); err != nil {
+			return fmt.Errorf("interfaces: parallel action %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Close closes every action.
+func (p *ParallelAction) Close() error {
+	return CloseAll(p.Actions...)
+}
@@ -0,0 +1,63 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrPauseUnsupported is returned by SetPaused for triggers that do not
+// implement Pausable.
+var ErrPauseUnsupported = errors.New("interfaces: trigger does not support pause")
+
+// PauseMode selects what a paused trigger does with the events it receives.
+type PauseMode int
+
+const (
+	// PauseDrop discards events received while paused.
+	PauseDrop PauseMode = iota
+	// PauseBuffer holds events received while paused and delivers them, in
+	// order, after Resume and before any newer event.
+	PauseBuffer
+)
+
+func (m PauseMode) String() string {
+	switch m {
+	case PauseDrop:
+		return "drop"
+	case PauseBuffer:
+		return "buffer"
+	}
+
+	return "unknown"
+}
+
+// Pausable is an optional interface for triggers that can temporarily stop
+// invoking the callback without releasing their underlying resources, e.g.
+// during a maintenance window.
+//
+// While paused the trigger keeps its connections open and handles incoming
+// events according to PauseMode, which is typically chosen through the
+// trigger's config. A buffering trigger may bound its buffer; what happens
+// when the bound is reached is up to the trigger and should be documented by
+// it. Buffered events that have not been delivered when Stop is called are
+// discarded. Pause and Resume are idempotent.
+type Pausable interface {
+	Pause(ctx context.Context) error
+	Resume(ctx context.Context) error
+	PauseMode() PauseMode
+}
+
+// SetPaused pauses or resumes the first trigger in t's chain (see TriggerAs)
+// that implements Pausable. It returns ErrPauseUnsupported if there is none.
+func SetPaused(ctx context.Context, t Trigger, paused bool) error {
+	p, ok := TriggerAs[Pausable](t)
+	if !ok {
+		return ErrPauseUnsupported
+	}
+
+	if paused {
+		return p.Pause(ctx)
+	}
+
+	return p.Resume(ctx)
+}
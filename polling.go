@@ -0,0 +1,213 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// PollFunc fetches the events that occurred since the previous call.
+type PollFunc func(ctx context.Context) ([]map[string]any, error)
+
+// PollingOption configures NewPollingTrigger.
+type PollingOption func(*pollingTrigger)
+
+// WithPollValidator sets the function run by the trigger's Validate.
+func WithPollValidator(validate func(ctx context.Context) error) PollingOption {
+	return func(p *pollingTrigger) {
+		p.validate = validate
+	}
+}
+
+// WithPollMaxBackoff caps the delay between polls after consecutive poll
+// errors. It defaults to ten times the polling interval.
+func WithPollMaxBackoff(d time.Duration) PollingOption {
+	return func(p *pollingTrigger) {
+		p.maxBackoff = d
+	}
+}
+
+// WithPollTriggerID sets the TriggerID of the events emitted by the trigger.
+func WithPollTriggerID(id string) PollingOption {
+	return func(p *pollingTrigger) {
+		p.triggerID = id
+	}
+}
+
+// WithPollLogger sets the logger used to report poll and callback errors. It
+// defaults to slog.Default().
+func WithPollLogger(logger *slog.Logger) PollingOption {
+	return func(p *pollingTrigger) {
+		p.logger = logger
+	}
+}
+
+// NewPollingTrigger returns a Trigger that calls poll once when started and
+// then every interval, invoking the callback once per returned item in order.
+//
+// When poll fails, the delay before the next poll doubles with every
+// consecutive failure, up to the maximum set with WithPollMaxBackoff, and
+// returns to interval after a successful poll. Poll and callback errors are
+// logged; a failed callback does not stop delivery of the remaining items.
+//
+// Start returns immediately. The loop ends when Stop is called or the context
+// passed to Start is done. Stopping aborts a poll that is in progress, but
+// items that were already fetched are still delivered, with a context that is
+// not cancelled by Stop, and Stop waits for those callbacks to return. If
+// Stop's context expires first, the loop keeps winding down and Start fails
+// until it has exited; calling Stop again waits for it. Validate is a no-op
+// unless WithPollValidator is given.
+func NewPollingTrigger(interval time.Duration, poll PollFunc, opts ...PollingOption) Trigger {
+	p := &pollingTrigger{
+		interval:   interval,
+		poll:       poll,
+		maxBackoff: 10 * interval,
+		logger:     slog.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+type pollingTrigger struct {
+	interval   time.Duration
+	poll       PollFunc
+	maxBackoff time.Duration
+	triggerID  string
+	validate   func(ctx context.Context) error
+	logger     *slog.Logger
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (p *pollingTrigger) Start(ctx context.Context, callback TriggerCallback) error {
+	if p.interval <= 0 {
+		return errors.New("interfaces: polling interval must be positive")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.done != nil {
+		select {
+		case <-p.done:
+		default:
+			return errors.New("interfaces: polling trigger already started")
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	p.cancel = cancel
+	p.done = make(chan struct{})
+
+	go p.run(ctx, callback, p.done)
+
+	return nil
+}
+
+func (p *pollingTrigger) run(ctx context.Context, callback TriggerCallback, done chan struct{}) {
+	defer close(done)
+
+	var failures int
+
+	for {
+		delay := p.interval
+
+		if err := p.pollOnce(ctx, callback); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			failures++
+			delay = p.backoff(failures)
+
+			p.logger.WarnContext(ctx, "poll failed", "trigger_id", p.triggerID, "error", err, "retry_in", delay)
+		} else {
+			failures = 0
+		}
+
+		if sleepContext(ctx, delay) != nil {
+			return
+		}
+	}
+}
+
+// backoff returns the delay after the given number of consecutive failures:
+// interval doubled per failure, capped at the maximum backoff.
+func (p *pollingTrigger) backoff(failures int) time.Duration {
+	limit := max(p.maxBackoff, p.interval)
+
+	delay := p.interval
+	for range failures {
+		if delay >= limit/2 {
+			return limit
+		}
+
+		delay *= 2
+	}
+
+	return delay
+}
+
+func (p *pollingTrigger) pollOnce(ctx context.Context, callback TriggerCallback) error {
+	items, err := p.poll(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	// Fetched items are delivered even if the trigger is stopped meanwhile,
+	// since the source will not return them again.
+	callbackCtx := context.WithoutCancel(ctx)
+
+	for _, item := range items {
+		event := TriggerEvent{TriggerID: p.triggerID, EmittedAt: now, Data: item}
+
+		if err := callback(callbackCtx, event); err != nil {
+			p.logger.WarnContext(ctx, "callback failed", "trigger_id", p.triggerID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (p *pollingTrigger) Stop(ctx context.Context) error {
+	p.mu.Lock()
+	cancel, done := p.cancel, p.done
+	p.mu.Unlock()
+
+	if done == nil {
+		return nil
+	}
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	p.mu.Lock()
+	if p.done == done {
+		p.cancel, p.done = nil, nil
+	}
+	p.mu.Unlock()
+
+	return nil
+}
+
+func (p *pollingTrigger) Validate(ctx context.Context) error {
+	if p.validate != nil {
+		return p.validate(ctx)
+	}
+
+	return nil
+}
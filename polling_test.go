@@ -0,0 +1,141 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+)
+
+func discardPollLogger() PollingOption {
+	return WithPollLogger(slog.New(slog.DiscardHandler))
+}
+
+func TestPollingTriggerDeliversItems(t *testing.T) {
+	var once sync.Once
+
+	poll := func(context.Context) ([]map[string]any, error) {
+		var items []map[string]any
+		once.Do(func() {
+			items = []map[string]any{{"n": 1}, {"n": 2}}
+		})
+
+		return items, nil
+	}
+
+	trigger := NewPollingTrigger(time.Millisecond, poll, WithPollTriggerID("poller"), discardPollLogger())
+
+	events := make(chan TriggerEvent, 2)
+	if err := trigger.Start(context.Background(), func(_ context.Context, event TriggerEvent) error {
+		events <- event
+		return nil
+	}); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	for want := 1; want <= 2; want++ {
+		select {
+		case event := <-events:
+			if event.TriggerID != "poller" || event.Data["n"] != want {
+				t.Errorf("event = %+v, want trigger poller with n = %d", event, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for item %d", want)
+		}
+	}
+
+	if err := trigger.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+}
+
+func TestPollingTriggerBackoff(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxBackoff time.Duration
+		failures   int
+		want       time.Duration
+	}{
+		{name: "no failures", maxBackoff: 50 * time.Millisecond, failures: 0, want: 10 * time.Millisecond},
+		{name: "first failure", maxBackoff: 50 * time.Millisecond, failures: 1, want: 20 * time.Millisecond},
+		{name: "second failure", maxBackoff: 50 * time.Millisecond, failures: 2, want: 40 * time.Millisecond},
+		{name: "capped", maxBackoff: 50 * time.Millisecond, failures: 3, want: 50 * time.Millisecond},
+		{name: "many failures", maxBackoff: 50 * time.Millisecond, failures: 100, want: 50 * time.Millisecond},
+		{name: "cap below interval", maxBackoff: 5 * time.Millisecond, failures: 2, want: 10 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPollingTrigger(10*time.Millisecond, nil, WithPollMaxBackoff(tt.maxBackoff)).(*pollingTrigger)
+
+			if got := p.backoff(tt.failures); got != tt.want {
+				t.Errorf("backoff(%d) = %v, want %v", tt.failures, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPollingTriggerRejectsNonPositiveInterval(t *testing.T) {
+	trigger := NewPollingTrigger(0, func(context.Context) ([]map[string]any, error) { return nil, nil })
+
+	if err := trigger.Start(context.Background(), func(context.Context, TriggerEvent) error { return nil }); err == nil {
+		t.Fatal("Start succeeded with a zero interval")
+	}
+}
+
+// TestPollingTriggerRestartAfterTimedOutStop covers a Stop whose context
+// expires while a callback is still running: Start must fail until the loop
+// has exited, and succeed once it has.
+func TestPollingTriggerRestartAfterTimedOutStop(t *testing.T) {
+	poll := func(context.Context) ([]map[string]any, error) {
+		return []map[string]any{{}}, nil
+	}
+
+	trigger := NewPollingTrigger(time.Hour, poll, discardPollLogger())
+
+	entered := make(chan struct{}, 1)
+	release := make(chan struct{})
+	blocking := func(context.Context, TriggerEvent) error {
+		select {
+		case entered <- struct{}{}:
+		default:
+		}
+		<-release
+
+		return nil
+	}
+
+	if err := trigger.Start(context.Background(), blocking); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	<-entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := trigger.Stop(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Stop = %v, want context.DeadlineExceeded", err)
+	}
+
+	noop := func(context.Context, TriggerEvent) error { return nil }
+	if err := trigger.Start(context.Background(), noop); err == nil {
+		t.Fatal("Start succeeded while the previous loop was still running")
+	}
+
+	close(release)
+
+	if err := trigger.Stop(context.Background()); err != nil {
+		t.Fatalf("second Stop: %v", err)
+	}
+
+	if err := trigger.Start(context.Background(), noop); err != nil {
+		t.Fatalf("Start after the loop exited: %v", err)
+	}
+
+	if err := trigger.Stop(context.Background()); err != nil {
+		t.Fatalf("final Stop: %v", err)
+	}
+}
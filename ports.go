@@ -0,0 +1,23 @@
+package interfaces
+
+// PortDescriber is an optional interface for action factories that declare
+// the shape of the data their actions consume and produce, as JSON Schema.
+// Unlike Schema, which describes the action's configuration, these describe
+// the values flowing between workflow steps, so an editor can check that the
+// OutputSchema of an upstream step is compatible with the InputSchema of a
+// downstream one.
+type PortDescriber interface {
+	InputSchema() map[string]any
+	OutputSchema() map[string]any
+}
+
+// PortsOf returns f's input and output schemas. ok is false if f does not
+// implement PortDescriber.
+func PortsOf(f ActionFactory) (in, out map[string]any, ok bool) {
+	pd, ok := f.(PortDescriber)
+	if !ok {
+		return nil, nil, false
+	}
+
+	return pd.InputSchema(), pd.OutputSchema(), true
+}
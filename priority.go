@@ -0,0 +1,30 @@
+package interfaces
+
+import "reflect"
+
+// Prioritized is an optional interface for action factories whose actions
+// should be scheduled ahead of, or behind, others when worker capacity is
+// limited. Priority returns a relative weight: higher values run first, and
+// the default for factories that don't implement Prioritized is 0.
+//
+// Priority is a scheduling hint, not a guarantee. An engine may sort pending
+// executions by it but is free to run a lower-priority action first, for
+// example to avoid starving it.
+type Prioritized interface {
+	Priority() int
+}
+
+// PriorityOf returns f's priority, or 0 if f is nil or does not implement
+// Prioritized.
+func PriorityOf(f ActionFactory) int {
+	p, ok := f.(Prioritized)
+	if !ok {
+		return 0
+	}
+
+	if v := reflect.ValueOf(p); v.Kind() == reflect.Pointer && v.IsNil() {
+		return 0
+	}
+
+	return p.Priority()
+}
@@ -0,0 +1,63 @@
+package interfaces
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// WithRateLimit returns a Trigger that forwards t's events to the callback at
+// most at rate r with bursts of up to burst events.
+//
+// Excess events are not dropped: the forwarding callback blocks until the
+// limiter admits the event, which applies backpressure to t. If the event's
+// context is done while waiting, the callback returns the context's error
+// without invoking the downstream callback, and the reserved token is
+// returned to the limiter. A burst below 1 would reject every event, so it is
+// raised to 1. Stop and Validate are delegated to t.
+func WithRateLimit(t Trigger, r rate.Limit, burst int) Trigger {
+	burst = max(burst, 1)
+
+	return &rateLimitedTrigger{inner: t, limiter: rate.NewLimiter(r, burst)}
+}
+
+type rateLimitedTrigger struct {
+	inner   Trigger
+	limiter *rate.Limiter
+}
+
+func (t *rateLimitedTrigger) Start(ctx context.Context, callback TriggerCallback) error {
+	return t.inner.Start(ctx, t.wrap(callback))
+}
+
+func (t *rateLimitedTrigger) StartFrom(ctx context.Context, checkpoint []byte, callback TriggerCallback) error {
+	return startFrom(ctx, t.inner, checkpoint, t.wrap(callback))
+}
+
+func (t *rateLimitedTrigger) wrap(callback TriggerCallback) TriggerCallback {
+	return func(ctx context.Context, event TriggerEvent) error {
+		if err := t.limiter.Wait(ctx); err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+
+			return err
+		}
+
+		return callback(ctx, event)
+	}
+}
+
+func (t *rateLimitedTrigger) Unwrap() Trigger {
+	return t.inner
+}
+
+func (*rateLimitedTrigger) forwardsStart() {}
+
+func (t *rateLimitedTrigger) Stop(ctx context.Context) error {
+	return t.inner.Stop(ctx)
+}
+
+func (t *rateLimitedTrigger) Validate(ctx context.Context) error {
+	return t.inner.Validate(ctx)
+}
@@ -0,0 +1,28 @@
+package interfaces
+
+import "context"
+
+// ReadyNotifier is an optional interface for triggers that become able to
+// receive events some time after Start returns, e.g. once a connection is
+// established or a topic subscribed. Ready returns a channel that is closed
+// when the trigger is fully operational; it may be called before Start.
+type ReadyNotifier interface {
+	Ready() <-chan struct{}
+}
+
+// WaitReady blocks until t is ready or ctx is done, returning ctx's error in
+// the latter case. It returns nil immediately if no trigger in t's chain (see
+// TriggerAs) implements ReadyNotifier.
+func WaitReady(ctx context.Context, t Trigger) error {
+	rn, ok := TriggerAs[ReadyNotifier](t)
+	if !ok {
+		return nil
+	}
+
+	select {
+	case <-rn.Ready():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
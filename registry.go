@@ -0,0 +1,218 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+)
+
+// Registry collects ActionFactory and TriggerFactory implementations keyed by
+// their ID. It is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	actions  map[string]ActionFactory
+	triggers map[string]TriggerFactory
+	observer ExecutionObserver
+}
+
+// RegistryOption configures a Registry.
+type RegistryOption func(*Registry)
+
+// WithObserver makes the registry notify obs around the Execute calls of every
+// action created by CreateAction. Use MultiObserver to install
+// several observers.
+func WithObserver(obs ExecutionObserver) RegistryOption {
+	return func(r *Registry) {
+		r.observer = obs
+	}
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry(opts ...RegistryOption) *Registry {
+	r := &Registry{
+		actions:  make(map[string]ActionFactory),
+		triggers: make(map[string]TriggerFactory),
+		observer: NoopObserver{},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// RegisterAction adds f to the registry. It returns an error if an action
+// factory with the same ID is already registered.
+func (r *Registry) RegisterAction(f ActionFactory) error {
+	if f == nil {
+		return fmt.Errorf("interfaces: cannot register nil action factory")
+	}
+
+	id := f.ID()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.actions[id]; exists {
+		return fmt.Errorf("interfaces: action factory %q already registered", id)
+	}
+
+	r.actions[id] = f
+
+	return nil
+}
+
+// RegisterTrigger adds f to the registry. It returns an error if a trigger
+// factory with the same ID is already registered.
+func (r *Registry) RegisterTrigger(f TriggerFactory) error {
+	if f == nil {
+		return fmt.Errorf("interfaces: cannot register nil trigger factory")
+	}
+
+	id := f.ID()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.triggers[id]; exists {
+		return fmt.Errorf("interfaces: trigger factory %q already registered", id)
+	}
+
+	r.triggers[id] = f
+
+	return nil
+}
+
+// MustRegisterAction is like RegisterAction but panics on error. It is meant
+// for use in package init blocks.
+func (r *Registry) MustRegisterAction(f ActionFactory) {
+	if err := r.RegisterAction(f); err != nil {
+		panic(err)
+	}
+}
+
+// MustRegisterTrigger is like RegisterTrigger but panics on error. It is meant
+// for use in package init blocks.
+func (r *Registry) MustRegisterTrigger(f TriggerFactory) {
+	if err := r.RegisterTrigger(f); err != nil {
+		panic(err)
+	}
+}
+
+// Action returns the action factory registered under id.
+func (r *Registry) Action(id string) (ActionFactory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, ok := r.actions[id]
+
+	return f, ok
+}
+
+// Trigger returns the trigger factory registered under id.
+func (r *Registry) Trigger(id string) (TriggerFactory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, ok := r.triggers[id]
+
+	return f, ok
+}
+
+// CreateAction looks up the action factory registered under id and uses it to
+// create an action. It returns a *FactoryNotFoundError if id is unknown and a
+// *ValidationError if config does not satisfy the factory's schema.
+//
+// The returned action wraps the created one (reachable through Unwrap). It
+// notifies the registry's observer around Execute and records id as the
+// ActionID of panics in Execute: a *PanicError returned by an inner
+// RecoverMiddleware gets it set, and a panic that escapes is re-raised as a
+// *PanicError carrying it, which SafeExecute and an outer RecoverMiddleware
+// return as is.
+func (r *Registry) CreateAction(ctx context.Context, id string, config map[string]any) (Action, error) {
+	f, ok := r.Action(id)
+	if !ok {
+		return nil, &FactoryNotFoundError{Kind: KindAction, ID: id}
+	}
+
+	if err := ValidateConfig(f.Schema(), config); err != nil {
+		return nil, err
+	}
+
+	action, err := f.Create(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	observer := r.observer
+	if observer == nil {
+		observer = NoopObserver{}
+	}
+
+	return observedAction{inner: action, actionID: id, observer: observer}, nil
+}
+
+// CreateTrigger looks up the trigger factory registered under id and uses it
+// to create a trigger. It returns a *FactoryNotFoundError if id is unknown and
+// a *ValidationError if config does not satisfy the factory's schema.
+func (r *Registry) CreateTrigger(ctx context.Context, id string, config map[string]any, logger *slog.Logger) (Trigger, error) {
+	f, ok := r.Trigger(id)
+	if !ok {
+		return nil, &FactoryNotFoundError{Kind: KindTrigger, ID: id}
+	}
+
+	if err := ValidateConfig(f.Schema(), config); err != nil {
+		return nil, err
+	}
+
+	return f.Create(ctx, config, logger)
+}
+
+// ActionIDs returns the IDs of all registered action factories in sorted order.
+func (r *Registry) ActionIDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return sortedKeys(r.actions)
+}
+
+// TriggerIDs returns the IDs of all registered trigger factories in sorted order.
+func (r *Registry) TriggerIDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return sortedKeys(r.triggers)
+}
+
+// Descriptors returns the descriptors of all registered factories, actions
+// first, each group sorted by ID.
+func (r *Registry) Descriptors() []FactoryDescriptor {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	descriptors := make([]FactoryDescriptor, 0, len(r.actions)+len(r.triggers))
+
+	for _, id := range sortedKeys(r.actions) {
+		descriptors = append(descriptors, DescribeAction(r.actions[id]))
+	}
+
+	for _, id := range sortedKeys(r.triggers) {
+		descriptors = append(descriptors, DescribeTrigger(r.triggers[id]))
+	}
+
+	return descriptors
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,52 @@
+package interfaces
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// ActionResult is the outcome of a successful Action.Execute call.
+type ActionResult struct {
+	// Output is the primary value produced by the action.
+	Output any
+	// Metadata carries auxiliary details such as HTTP status codes or row
+	// counts that should not be part of Output.
+	Metadata map[string]any
+	// Duration is how long the execution took.
+	Duration time.Duration
+	// Skipped reports that the action was not run, e.g. because its
+	// condition was false, as opposed to having run successfully.
+	Skipped bool
+}
+
+// LegacyExecuteFunc is the Execute signature used before ActionResult existed.
+type LegacyExecuteFunc func(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (any, error)
+
+// LegacyAction adapts an old-style execute function to the Action interface.
+// The returned action wraps the function's output in an ActionResult, records
+// its duration and has a no-op Validate.
+func LegacyAction(fn LegacyExecuteFunc) Action {
+	return legacyAction{fn: fn}
+}
+
+type legacyAction struct {
+	fn LegacyExecuteFunc
+}
+
+func (a legacyAction) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	start := time.Now()
+
+	output, err := a.fn(ctx, executionCtx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ActionResult{Output: output, Duration: time.Since(start)}, nil
+}
+
+func (a legacyAction) Validate(context.Context) error {
+	return nil
+}
@@ -0,0 +1,171 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// RetryPolicy describes how the engine may retry a failed Execute.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 1 are treated as 1.
+	MaxAttempts int
+	// BaseDelay is the wait before the first retry.
+	BaseDelay time.Duration
+	// Multiplier scales the delay after each retry. Values below 1 are
+	// treated as 1, i.e. a constant delay.
+	Multiplier float64
+	// Retryable reports whether err may be retried. A nil Retryable retries
+	// every error.
+	Retryable func(err error) bool
+}
+
+// RetryPolicyProvider is an optional interface for action factories that
+// declare how their actions may be retried.
+type RetryPolicyProvider interface {
+	RetryPolicy() RetryPolicy
+}
+
+// DefaultRetryPolicy returns a policy of 3 attempts with exponential backoff
+// starting at 500ms and doubling per retry.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		Multiplier:  2,
+	}
+}
+
+// NoRetry returns a policy that never retries.
+func NoRetry() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 1,
+		Retryable:   func(error) bool { return false },
+	}
+}
+
+// RetryPolicyOf returns f's retry policy if it implements RetryPolicyProvider.
+func RetryPolicyOf(f ActionFactory) (RetryPolicy, bool) {
+	if p, ok := f.(RetryPolicyProvider); ok {
+		return p.RetryPolicy(), true
+	}
+
+	return RetryPolicy{}, false
+}
+
+// ShouldRetry reports whether err may be retried under p.
+func (p RetryPolicy) ShouldRetry(err error) bool {
+	if p.Retryable == nil {
+		return true
+	}
+
+	return p.Retryable(err)
+}
+
+// Delay returns the backoff before the given retry, where retry 1 is the
+// first retry after the initial attempt.
+func (p RetryPolicy) Delay(retry int) time.Duration {
+	if retry < 1 {
+		return 0
+	}
+
+	multiplier := max(p.Multiplier, 1)
+	delay := float64(p.BaseDelay) * math.Pow(multiplier, float64(retry-1))
+
+	if delay > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(delay)
+}
+
+// RetriesExhaustedError reports that every attempt allowed by a RetryPolicy
+// failed.
+type RetriesExhaustedError struct {
+	Attempts int
+	Last     error
+}
+
+func (e *RetriesExhaustedError) Error() string {
+	return fmt.Sprintf("interfaces: action failed after %d attempts: %v", e.Attempts, e.Last)
+}
+
+func (e *RetriesExhaustedError) Unwrap() error {
+	return e.Last
+}
+
+// ExecuteWithRetry executes a, retrying failures according to policy with
+// exponential backoff and full jitter: before retry n it sleeps a random
+// duration in [0, policy.Delay(n)).
+//
+// An error that policy does not consider retryable is returned as is. When
+// every attempt fails, the last error is returned in a
+// *RetriesExhaustedError. If ctx is done while waiting between attempts,
+// ExecuteWithRetry returns ctx's error immediately.
+func ExecuteWithRetry(ctx context.Context, a Action, executionCtx models.ExecutionContext, logger *slog.Logger, policy RetryPolicy) (*ActionResult, error) {
+	attempts := max(policy.MaxAttempts, 1)
+
+	var lastErr error
+
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			if err := sleepContext(ctx, jitter(policy.Delay(attempt-1))); err != nil {
+				return nil, err
+			}
+		}
+
+		result, err := a.Execute(ctx, executionCtx, logger)
+		if err == nil {
+			return result, nil
+		}
+
+		if !policy.ShouldRetry(err) {
+			return nil, err
+		}
+
+		lastErr = err
+
+		if attempt < attempts {
+			orDefaultLogger(logger).Warn("action failed, retrying", "attempt", attempt, "max_attempts", attempts, "error", err)
+		}
+	}
+
+	return nil, &RetriesExhaustedError{Attempts: attempts, Last: lastErr}
+}
+
+// jitter returns a random duration in [0, d).
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+
+	return rand.N(d)
+}
+
+// sleepContext waits for d or until ctx is done, returning ctx's error in the
+// latter case.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,120 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// Route delivers the events matching Match to Callback. A route with a nil
+// Callback delivers to the callback passed to the routing trigger's Start.
+type Route struct {
+	Match    func(TriggerEvent) bool
+	Callback TriggerCallback
+}
+
+// RoutingOption configures NewRoutingTrigger.
+type RoutingOption func(*routingTrigger)
+
+// WithDefaultRoute sets the callback that receives events matching no route.
+func WithDefaultRoute(callback TriggerCallback) RoutingOption {
+	return func(r *routingTrigger) {
+		r.fallback = callback
+	}
+}
+
+// WithRoutingLogger sets the logger used to report unrouted events. It
+// defaults to slog.Default().
+func WithRoutingLogger(logger *slog.Logger) RoutingOption {
+	return func(r *routingTrigger) {
+		r.logger = logger
+	}
+}
+
+// NewRoutingTrigger returns a Trigger that dispatches each event of inner to
+// every route whose Match returns true, in order, so a single event source can
+// start different workflows depending on the event content. The errors of
+// all matching callbacks are joined. Events matching no route go to the
+// default route, if set, and are otherwise dropped with a debug log. Under
+// StartWithCheckpoints, only events handled by the callback passed to Start
+// have their checkpoint saved, so routes that should advance it leave
+// Callback nil. Stop and Validate are delegated to inner.
+func NewRoutingTrigger(inner Trigger, routes []Route, opts ...RoutingOption) Trigger {
+	r := &routingTrigger{
+		inner:  inner,
+		routes: routes,
+		logger: slog.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+type routingTrigger struct {
+	inner    Trigger
+	routes   []Route
+	fallback TriggerCallback
+	logger   *slog.Logger
+}
+
+func (r *routingTrigger) Start(ctx context.Context, callback TriggerCallback) error {
+	return r.inner.Start(ctx, r.wrap(callback))
+}
+
+func (r *routingTrigger) StartFrom(ctx context.Context, checkpoint []byte, callback TriggerCallback) error {
+	return startFrom(ctx, r.inner, checkpoint, r.wrap(callback))
+}
+
+func (r *routingTrigger) wrap(callback TriggerCallback) TriggerCallback {
+	return func(ctx context.Context, event TriggerEvent) error {
+		var errs []error
+
+		matched := false
+
+		for _, route := range r.routes {
+			if !route.Match(event) {
+				continue
+			}
+
+			matched = true
+
+			target := route.Callback
+			if target == nil {
+				target = callback
+			}
+
+			if err := target(ctx, event); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		if matched {
+			return errors.Join(errs...)
+		}
+
+		if r.fallback != nil {
+			return r.fallback(ctx, event)
+		}
+
+		r.logger.DebugContext(ctx, "dropping event matching no route", "trigger_id", event.TriggerID, "dedup_key", event.DedupKey)
+
+		return nil
+	}
+}
+
+func (r *routingTrigger) Unwrap() Trigger {
+	return r.inner
+}
+
+func (*routingTrigger) forwardsStart() {}
+
+func (r *routingTrigger) Stop(ctx context.Context) error {
+	return r.inner.Stop(ctx)
+}
+
+func (r *routingTrigger) Validate(ctx context.Context) error {
+	return r.inner.Validate(ctx)
+}
@@ -0,0 +1,37 @@
+package interfaces
+
+import "time"
+
+// Scheduled is an optional interface for time-based triggers such as cron or
+// delay triggers.
+//
+// NextFireTime returns the first fire time strictly after now. The bool is
+// false if the trigger has no upcoming fire time or is not time-based at all.
+// It must be free of side effects and safe to call while the trigger is
+// running.
+type Scheduled interface {
+	NextFireTime(now time.Time) (time.Time, bool)
+}
+
+// NextFireTimes returns up to n upcoming fire times of t after now, in order.
+// It returns nil if t does not implement Scheduled.
+func NextFireTimes(t Trigger, now time.Time, n int) []time.Time {
+	s, ok := t.(Scheduled)
+	if !ok || n <= 0 {
+		return nil
+	}
+
+	times := make([]time.Time, 0, n)
+
+	for len(times) < n {
+		next, ok := s.NextFireTime(now)
+		if !ok || !next.After(now) {
+			break
+		}
+
+		times = append(times, next)
+		now = next
+	}
+
+	return times
+}
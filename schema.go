@@ -0,0 +1,363 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ValidateConfig checks config against a JSON Schema as returned by a factory's
+// Schema method. A nil or empty schema accepts any config. Violations are
+// reported in a *ValidationError with one FieldError per failing value, whose
+// Path is a JSON Pointer to the value ("" for the config itself).
+//
+// The supported keywords are type, enum, const, properties, required,
+// additionalProperties, items, minItems, maxItems, minLength, maxLength,
+// pattern, minimum, maximum, exclusiveMinimum, exclusiveMaximum, allOf, anyOf
+// and oneOf. Unknown keywords are ignored.
+func ValidateConfig(schema map[string]any, config map[string]any) error {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	var root any = config
+	if config == nil {
+		root = map[string]any{}
+	}
+
+	verr := NewValidationError()
+
+	validateValue(schema, root, "", verr)
+
+	return verr.ErrOrNil()
+}
+
+func validateValue(schema map[string]any, value any, path string, out *ValidationError) {
+	report := func(format string, args ...any) {
+		out.Add(path, fmt.Sprintf(format, args...))
+	}
+
+	if t, ok := schema["type"]; ok {
+		types := stringList(t)
+		if !slices.ContainsFunc(types, func(typ string) bool { return matchesType(typ, value) }) {
+			report("expected %s, got %s", strings.Join(types, " or "), jsonType(value))
+
+			return
+		}
+	}
+
+	if enum, ok := schema["enum"]; ok {
+		if !slices.ContainsFunc(anyList(enum), func(e any) bool { return jsonEqual(e, value) }) {
+			report("value is not one of the allowed values")
+		}
+	}
+
+	if c, ok := schema["const"]; ok && !jsonEqual(c, value) {
+		report("value must be %v", c)
+	}
+
+	if obj, ok := asObject(value); ok {
+		validateObject(schema, obj, path, out)
+	}
+
+	if arr, ok := asList(value); ok {
+		validateArray(schema, arr, path, report, out)
+	}
+
+	if s, ok := value.(string); ok {
+		validateString(schema, s, report)
+	}
+
+	if n, ok := asNumber(value); ok {
+		validateNumber(schema, n, report)
+	}
+
+	validateCombinators(schema, value, path, report, out)
+}
+
+func validateObject(schema map[string]any, obj map[string]any, path string, out *ValidationError) {
+	for _, name := range stringList(schema["required"]) {
+		if _, ok := obj[name]; !ok {
+			out.Add(joinPointer(path, name), "required property is missing")
+		}
+	}
+
+	props, _ := schema["properties"].(map[string]any)
+
+	keys := sortedKeys(obj)
+	for _, key := range keys {
+		if sub, ok := props[key].(map[string]any); ok {
+			validateValue(sub, obj[key], joinPointer(path, key), out)
+
+			continue
+		}
+
+		if _, declared := props[key]; declared {
+			continue
+		}
+
+		switch ap := schema["additionalProperties"].(type) {
+		case bool:
+			if !ap {
+				out.Add(joinPointer(path, key), "additional property is not allowed")
+			}
+		case map[string]any:
+			validateValue(ap, obj[key], joinPointer(path, key), out)
+		}
+	}
+}
+
+func validateArray(schema map[string]any, arr []any, path string, report func(string, ...any), out *ValidationError) {
+	if n, ok := asNumber(schema["minItems"]); ok && float64(len(arr)) < n {
+		report("expected at least %v items, got %d", n, len(arr))
+	}
+
+	if n, ok := asNumber(schema["maxItems"]); ok && float64(len(arr)) > n {
+		report("expected at most %v items, got %d", n, len(arr))
+	}
+
+	if items, ok := schema["items"].(map[string]any); ok {
+		for i, item := range arr {
+			validateValue(items, item, joinPointer(path, strconv.Itoa(i)), out)
+		}
+	}
+}
+
+func validateString(schema map[string]any, s string, report func(string, ...any)) {
+	length := float64(len([]rune(s)))
+
+	if n, ok := asNumber(schema["minLength"]); ok && length < n {
+		report("expected at least %v characters", n)
+	}
+
+	if n, ok := asNumber(schema["maxLength"]); ok && length > n {
+		report("expected at most %v characters", n)
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			report("schema pattern %q is invalid: %v", pattern, err)
+		} else if !re.MatchString(s) {
+			report("value does not match pattern %q", pattern)
+		}
+	}
+}
+
+func validateNumber(schema map[string]any, n float64, report func(string, ...any)) {
+	if lim, ok := asNumber(schema["minimum"]); ok && n < lim {
+		report("value must be >= %v", lim)
+	}
+
+	if lim, ok := asNumber(schema["maximum"]); ok && n > lim {
+		report("value must be <= %v", lim)
+	}
+
+	if lim, ok := asNumber(schema["exclusiveMinimum"]); ok && n <= lim {
+		report("value must be > %v", lim)
+	}
+
+	if lim, ok := asNumber(schema["exclusiveMaximum"]); ok && n >= lim {
+		report("value must be < %v", lim)
+	}
+}
+
+func validateCombinators(schema map[string]any, value any, path string, report func(string, ...any), out *ValidationError) {
+	for _, sub := range schemaList(schema["allOf"]) {
+		validateValue(sub, value, path, out)
+	}
+
+	if subs := schemaList(schema["anyOf"]); len(subs) > 0 {
+		if countMatches(subs, value) == 0 {
+			report("value does not match any of the allowed schemas")
+		}
+	}
+
+	if subs := schemaList(schema["oneOf"]); len(subs) > 0 {
+		if n := countMatches(subs, value); n != 1 {
+			report("value must match exactly one schema, matched %d", n)
+		}
+	}
+}
+
+func countMatches(schemas []map[string]any, value any) int {
+	matched := 0
+
+	for _, sub := range schemas {
+		verr := NewValidationError()
+
+		validateValue(sub, value, "", verr)
+
+		if len(verr.Errors) == 0 {
+			matched++
+		}
+	}
+
+	return matched
+}
+
+func matchesType(typ string, value any) bool {
+	switch typ {
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := asNumber(value)
+		return ok
+	case "integer":
+		n, ok := asNumber(value)
+		return ok && n == math.Trunc(n)
+	case "object":
+		_, ok := asObject(value)
+		return ok
+	case "array":
+		_, ok := asList(value)
+		return ok
+	}
+
+	return false
+}
+
+func jsonType(value any) string {
+	if value == nil {
+		return "null"
+	}
+
+	for _, typ := range []string{"boolean", "string", "integer", "number", "object", "array"} {
+		if matchesType(typ, value) {
+			return typ
+		}
+	}
+
+	return fmt.Sprintf("%T", value)
+}
+
+func jsonEqual(a, b any) bool {
+	if x, ok := asNumber(a); ok {
+		y, ok := asNumber(b)
+		return ok && x == y
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// asNumber returns value as a float64 if it is a Go number or a json.Number,
+// as produced by a decoder with UseNumber.
+func asNumber(value any) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+
+	if num, ok := value.(json.Number); ok {
+		f, err := num.Float64()
+		return f, err == nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+
+	return 0, false
+}
+
+// asObject returns value as a map[string]any if it is a map with string keys,
+// such as the map[string]string of a config built in Go.
+func asObject(value any) (map[string]any, bool) {
+	if obj, ok := value.(map[string]any); ok {
+		return obj, true
+	}
+
+	if value == nil {
+		return nil, false
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+
+	obj := make(map[string]any, v.Len())
+	for it := v.MapRange(); it.Next(); {
+		obj[it.Key().String()] = it.Value().Interface()
+	}
+
+	return obj, true
+}
+
+func asList(value any) ([]any, bool) {
+	if list, ok := value.([]any); ok {
+		return list, true
+	}
+
+	if value == nil {
+		return nil, false
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	list := make([]any, v.Len())
+	for i := range list {
+		list[i] = v.Index(i).Interface()
+	}
+
+	return list, true
+}
+
+func anyList(value any) []any {
+	list, _ := asList(value)
+	return list
+}
+
+func stringList(value any) []string {
+	if s, ok := value.(string); ok {
+		return []string{s}
+	}
+
+	var out []string
+
+	for _, item := range anyList(value) {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+
+	return out
+}
+
+func schemaList(value any) []map[string]any {
+	var out []map[string]any
+
+	for _, item := range anyList(value) {
+		if m, ok := item.(map[string]any); ok {
+			out = append(out, m)
+		}
+	}
+
+	return out
+}
+
+func joinPointer(path, token string) string {
+	token = strings.ReplaceAll(token, "~", "~0")
+	token = strings.ReplaceAll(token, "/", "~1")
+
+	return path + "/" + token
+}
@@ -0,0 +1,128 @@
+package interfaces
+
+import (
+	"maps"
+	"slices"
+)
+
+// SchemaDraft07 is the $schema URI of the schemas produced by this package.
+const SchemaDraft07 = "http://json-schema.org/draft-07/schema#"
+
+// SchemaBuilder builds a draft-07 JSON Schema for a factory's configuration
+// object:
+//
+//	NewSchema().
+//		Required("url").
+//		StringProp("url", "the endpoint").
+//		IntProp("timeout", "timeout in seconds").
+//		Build()
+type SchemaBuilder struct {
+	description string
+	properties  map[string]any
+	required    []string
+	additional  *bool
+}
+
+// NewSchema returns a builder for an object schema.
+func NewSchema() *SchemaBuilder {
+	return &SchemaBuilder{properties: make(map[string]any)}
+}
+
+// Object marks the schema as describing an object. Built schemas are always
+// objects; Object exists so builder chains read naturally.
+func (b *SchemaBuilder) Object() *SchemaBuilder {
+	return b
+}
+
+// Description sets the schema's description.
+func (b *SchemaBuilder) Description(description string) *SchemaBuilder {
+	b.description = description
+	return b
+}
+
+// Required marks the named properties as required.
+func (b *SchemaBuilder) Required(names ...string) *SchemaBuilder {
+	for _, name := range names {
+		if !slices.Contains(b.required, name) {
+			b.required = append(b.required, name)
+		}
+	}
+
+	return b
+}
+
+// AdditionalProperties sets whether properties not declared in the schema are
+// allowed.
+func (b *SchemaBuilder) AdditionalProperties(allowed bool) *SchemaBuilder {
+	b.additional = &allowed
+	return b
+}
+
+// Prop adds a property with an arbitrary schema.
+func (b *SchemaBuilder) Prop(name string, schema map[string]any) *SchemaBuilder {
+	b.properties[name] = schema
+	return b
+}
+
+// StringProp adds a string property.
+func (b *SchemaBuilder) StringProp(name, description string) *SchemaBuilder {
+	return b.Prop(name, typedProp("string", description))
+}
+
+// IntProp adds an integer property.
+func (b *SchemaBuilder) IntProp(name, description string) *SchemaBuilder {
+	return b.Prop(name, typedProp("integer", description))
+}
+
+// NumberProp adds a number property.
+func (b *SchemaBuilder) NumberProp(name, description string) *SchemaBuilder {
+	return b.Prop(name, typedProp("number", description))
+}
+
+// BoolProp adds a boolean property.
+func (b *SchemaBuilder) BoolProp(name, description string) *SchemaBuilder {
+	return b.Prop(name, typedProp("boolean", description))
+}
+
+// ArrayProp adds an array property whose items match items.
+func (b *SchemaBuilder) ArrayProp(name, description string, items map[string]any) *SchemaBuilder {
+	prop := typedProp("array", description)
+	if items != nil {
+		prop["items"] = items
+	}
+
+	return b.Prop(name, prop)
+}
+
+// Build returns the schema. It always has type "object", a properties map
+// and a required array, even if they are empty.
+func (b *SchemaBuilder) Build() map[string]any {
+	required := make([]string, len(b.required))
+	copy(required, b.required)
+
+	schema := map[string]any{
+		"$schema":    SchemaDraft07,
+		"type":       "object",
+		"properties": maps.Clone(b.properties),
+		"required":   required,
+	}
+
+	if b.description != "" {
+		schema["description"] = b.description
+	}
+
+	if b.additional != nil {
+		schema["additionalProperties"] = *b.additional
+	}
+
+	return schema
+}
+
+func typedProp(typ, description string) map[string]any {
+	prop := map[string]any{"type": typ}
+	if description != "" {
+		prop["description"] = description
+	}
+
+	return prop
+}
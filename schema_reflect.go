@@ -0,0 +1,283 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	rawMessageType    = reflect.TypeFor[json.RawMessage]()
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+)
+
+// ReflectSchema derives a draft-07 JSON Schema from the struct (or pointer to
+// struct) v, so a factory can keep a typed config struct as the source of
+// truth for its Schema.
+//
+// Property names follow the json struct tag, and fields tagged json:"-" are
+// skipped. The jsonschema struct tag holds comma-separated options:
+//
+//	required            the property is required
+//	description=TEXT    sets the description
+//	title=TEXT          sets the title
+//	default=VALUE       sets the default
+//	enum=VALUE          adds an allowed value; repeat for more values
+//	minimum=N, maximum=N, minLength=N, maxLength=N, pattern=REGEXP
+//
+// A comma inside a value does not start a new option unless it is followed by
+// one of the option names above. Values are parsed according to the field's
+// type. Only fields tagged
+// required appear in the required array. A struct type nested inside itself is
+// described as a plain object.
+func ReflectSchema(v any) map[string]any {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{},
+		"required":   []string{},
+	}
+
+	if t != nil && t.Kind() == reflect.Struct {
+		schema = reflectStruct(t, map[reflect.Type]bool{})
+	}
+
+	schema["$schema"] = SchemaDraft07
+
+	return schema
+}
+
+func reflectType(t reflect.Type, visiting map[reflect.Type]bool) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case t == rawMessageType:
+		return map[string]any{}
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		// Custom encodings can produce any JSON value.
+		return map[string]any{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// encoding/json encodes byte slices as base64 strings.
+			return map[string]any{"type": "string", "contentEncoding": "base64"}
+		}
+
+		return map[string]any{"type": "array", "items": reflectType(t.Elem(), visiting)}
+	case reflect.Map:
+		schema := map[string]any{"type": "object"}
+		if t.Key().Kind() == reflect.String {
+			schema["additionalProperties"] = reflectType(t.Elem(), visiting)
+		}
+
+		return schema
+	case reflect.Struct:
+		if visiting[t] {
+			return map[string]any{"type": "object"}
+		}
+
+		return reflectStruct(t, visiting)
+	}
+
+	return map[string]any{}
+}
+
+func reflectStruct(t reflect.Type, visiting map[reflect.Type]bool) map[string]any {
+	properties := map[string]any{}
+	required := []string{}
+
+	visiting[t] = true
+	collectFields(t, visiting, properties, &required)
+	delete(visiting, t)
+
+	return map[string]any{
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+}
+
+func collectFields(t reflect.Type, visiting map[reflect.Type]bool, properties map[string]any, required *[]string) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct {
+				collectFields(ft, visiting, properties, required)
+
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		prop := reflectType(field.Type, visiting)
+
+		if applySchemaTag(prop, field) {
+			*required = append(*required, name)
+		}
+
+		properties[name] = prop
+	}
+}
+
+// jsonFieldName returns the name from field's json tag, "" if the tag has no
+// name, and false if the field is excluded from JSON.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+
+	return name, true
+}
+
+// applySchemaTag applies field's jsonschema tag options to prop and reports
+// whether the field is required.
+func applySchemaTag(prop map[string]any, field reflect.StructField) bool {
+	tag, ok := field.Tag.Lookup("jsonschema")
+	if !ok {
+		return false
+	}
+
+	ft := field.Type
+	for ft.Kind() == reflect.Pointer {
+		ft = ft.Elem()
+	}
+
+	required := false
+
+	for _, opt := range splitSchemaTag(tag) {
+		key, value, hasValue := strings.Cut(strings.TrimSpace(opt), "=")
+
+		switch {
+		case key == "required" && !hasValue:
+			required = true
+		case key == "description", key == "title", key == "pattern":
+			prop[key] = value
+		case key == "default":
+			prop[key] = parseTagValue(ft, value)
+		case key == "enum":
+			enum, _ := prop["enum"].([]any)
+			prop["enum"] = append(enum, parseTagValue(ft, value))
+		case key == "minimum", key == "maximum":
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				prop[key] = n
+			}
+		case key == "minLength", key == "maxLength":
+			if n, err := strconv.Atoi(value); err == nil {
+				prop[key] = n
+			}
+		}
+	}
+
+	return required
+}
+
+// schemaTagKeys are the options of the jsonschema struct tag.
+var schemaTagKeys = []string{
+	"required", "description", "title", "default", "enum",
+	"minimum", "maximum", "minLength", "maxLength", "pattern",
+}
+
+// splitSchemaTag splits a jsonschema tag into its options. A comma only
+// separates options when it is followed by a known option, so values such as
+// patterns and descriptions may contain commas.
+func splitSchemaTag(tag string) []string {
+	var opts []string
+
+	start := 0
+
+	for i := 0; i < len(tag); i++ {
+		if tag[i] == ',' && startsWithSchemaOption(tag[i+1:]) {
+			opts = append(opts, tag[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(opts, tag[start:])
+}
+
+func startsWithSchemaOption(s string) bool {
+	s = strings.TrimLeft(s, " ")
+
+	for _, key := range schemaTagKeys {
+		rest, ok := strings.CutPrefix(s, key)
+		if !ok {
+			continue
+		}
+
+		rest = strings.TrimLeft(rest, " ")
+		if key == "required" && (rest == "" || rest[0] == ',') {
+			return true
+		}
+
+		if key != "required" && strings.HasPrefix(rest, "=") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseTagValue converts a tag value to the JSON value matching t's kind,
+// falling back to the raw string.
+func parseTagValue(t reflect.Type, value string) any {
+	switch t.Kind() {
+	case reflect.Bool:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Float32, reflect.Float64:
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			return n
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,98 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strconv"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// SequentialAction runs its actions one after another. Each action sees the
+// outputs of the actions before it in ExecutionContext.StepResults, keyed by
+// SequenceStepID of their index. The caller's ExecutionContext is not
+// modified.
+type SequentialAction struct {
+	Actions []Action
+}
+
+// NewSequential returns an Action that runs actions in order and returns the
+// result of the last one, or an empty result if there are no actions.
+func NewSequential(actions ...Action) Action {
+	return &SequentialAction{Actions: actions}
+}
+
+// SequenceStepID is the StepResults key under which SequentialAction stores
+// the output of its i-th action.
+func SequenceStepID(i int) string {
+	return "sequence_" + strconv.Itoa(i)
+}
+
+// SequenceError reports the failure of one action of a SequentialAction.
+type SequenceError struct {
+	// Index is the position of the action that failed.
+	Index int
+	// Results holds the results of the actions that completed before it.
+	Results []*ActionResult
+	Err     error
+}
+
+func (e *SequenceError) Error() string {
+	return fmt.Sprintf("interfaces: sequence step %d failed: %v", e.Index, e.Err)
+}
+
+func (e *SequenceError) Unwrap() error {
+	return e.Err
+}
+
+func (s *SequentialAction) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	executionCtx.StepResults = maps.Clone(executionCtx.StepResults)
+	if executionCtx.StepResults == nil {
+		executionCtx.StepResults = make(map[string]any, len(s.Actions))
+	}
+
+	results := make([]*ActionResult, 0, len(s.Actions))
+
+	var last *ActionResult
+
+	for i, action := range s.Actions {
+		result, err := action.Execute(ctx, executionCtx, logger)
+		if err != nil {
+			return nil, &SequenceError{Index: i, Results: results, Err: err}
+		}
+
+		results = append(results, result)
+		last = result
+
+		var output any
+		if result != nil {
+			output = result.Output
+		}
+
+		executionCtx.StepResults[SequenceStepID(i)] = output
+	}
+
+	if last == nil {
+		last = &ActionResult{}
+	}
+
+	return last, nil
+}
+
+// Validate validates every action and returns the first failure.
+func (s *SequentialAction) Validate(ctx context.Context) error {
+	for i, action := range s.Actions {
+		if err := action.Validate(ctx); err != nil {
+			return fmt.Errorf("interfaces: sequence step %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Close closes every action.
+func (s *SequentialAction) Close() error {
+	return CloseAll(s.Actions...)
+}
@@ -0,0 +1,56 @@
+package interfaces
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// StreamChunk is one increment of a streaming action's output.
+type StreamChunk struct {
+	Data any
+	// Err reports a failure after the stream started. A chunk with a non-nil
+	// Err must be the last chunk sent before the channel is closed; its Data
+	// is ignored.
+	Err error
+}
+
+// StreamingAction is an optional interface for actions that produce output
+// incrementally, such as log tailing or LLM token streams.
+//
+// An error returned by ExecuteStream means the stream could not be started and
+// no channel is returned. Once the channel is returned, the action sends
+// chunks on it and closes it when done; a failure at that point is reported
+// as a final chunk with Err set. The action must stop sending and close the
+// channel when ctx is done.
+type StreamingAction interface {
+	ExecuteStream(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (<-chan StreamChunk, error)
+}
+
+// Collect drains ch and aggregates it into a single result whose Output is
+// the []any of chunk data, in order. If the stream ends with an error chunk,
+// Collect returns the data received before it together with that error.
+func Collect(ch <-chan StreamChunk) (*ActionResult, error) {
+	start := time.Now()
+	output := []any{}
+
+	var streamErr error
+
+	for chunk := range ch {
+		if streamErr != nil {
+			continue
+		}
+
+		if chunk.Err != nil {
+			streamErr = chunk.Err
+
+			continue
+		}
+
+		output = append(output, chunk.Data)
+	}
+
+	return &ActionResult{Output: output, Duration: time.Since(start)}, streamErr
+}
@@ -0,0 +1,158 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrSurgeTripped is returned by the callback of a trigger wrapped with
+// WithSurgeGuard for events blocked while the guard is tripped.
+var ErrSurgeTripped = errors.New("interfaces: surge guard tripped")
+
+// Resetter is implemented by wrappers that hold state an operator can clear,
+// such as the trigger returned by WithSurgeGuard.
+type Resetter interface {
+	Reset()
+}
+
+// WithSurgeGuard returns a Trigger that stops forwarding t's events when more
+// than maxPerWindow of them arrive within a rolling window. Unlike
+// WithRateLimit it does not smooth traffic: it is a safety circuit against
+// runaway event storms.
+//
+// Once tripped, the guard calls onTrip (if non-nil) with the number of events
+// counted in the window and returns ErrSurgeTripped from the callback of every
+// blocked event; under OutcomeOf those events are requeued. onTrip runs once
+// per trip. Blocked events still count towards the window, so the guard closes
+// again only once the incoming rate falls back to maxPerWindow per window. The
+// returned Trigger implements Resetter, whose Reset closes the guard and
+// clears the count immediately.
+//
+// The window is approximated with two fixed buckets weighted by elapsed time,
+// so memory use is constant regardless of event volume. A non-positive
+// maxPerWindow or window disables the guard. Stop and Validate are delegated
+// to t.
+func WithSurgeGuard(t Trigger, maxPerWindow int, window time.Duration, onTrip func(count int)) Trigger {
+	return &surgeGuardTrigger{
+		inner:  t,
+		max:    maxPerWindow,
+		window: window,
+		onTrip: onTrip,
+	}
+}
+
+type surgeGuardTrigger struct {
+	inner  Trigger
+	max    int
+	window time.Duration
+	onTrip func(count int)
+
+	mu          sync.Mutex
+	bucketStart time.Time
+	current     int
+	previous    int
+	tripped     bool
+}
+
+func (s *surgeGuardTrigger) Start(ctx context.Context, callback TriggerCallback) error {
+	return s.inner.Start(ctx, s.wrap(callback))
+}
+
+func (s *surgeGuardTrigger) StartFrom(ctx context.Context, checkpoint []byte, callback TriggerCallback) error {
+	return startFrom(ctx, s.inner, checkpoint, s.wrap(callback))
+}
+
+func (s *surgeGuardTrigger) wrap(callback TriggerCallback) TriggerCallback {
+	return func(ctx context.Context, event TriggerEvent) error {
+		if s.max <= 0 || s.window <= 0 {
+			return callback(ctx, event)
+		}
+
+		allowed, tripCount := s.admit(time.Now())
+		if tripCount > 0 && s.onTrip != nil {
+			s.onTrip(tripCount)
+		}
+
+		if !allowed {
+			return ErrSurgeTripped
+		}
+
+		return callback(ctx, event)
+	}
+}
+
+// admit counts an event arriving at now and reports whether it may be
+// forwarded. tripCount is non-zero only for the event that trips the guard.
+func (s *surgeGuardTrigger) admit(now time.Time) (allowed bool, tripCount int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.advance(now)
+	s.current++
+
+	elapsed := float64(now.Sub(s.bucketStart)) / float64(s.window)
+	count := int(float64(s.previous)*(1-elapsed)) + s.current
+
+	switch {
+	case count <= s.max:
+		s.tripped = false
+
+		return true, 0
+	case !s.tripped:
+		s.tripped = true
+
+		return false, count
+	}
+
+	return false, 0
+}
+
+// advance rotates the buckets so that bucketStart is the start of the window
+// containing now.
+func (s *surgeGuardTrigger) advance(now time.Time) {
+	if s.bucketStart.IsZero() {
+		s.bucketStart = now
+
+		return
+	}
+
+	n := now.Sub(s.bucketStart) / s.window
+	if n <= 0 {
+		return
+	}
+
+	if n == 1 {
+		s.previous = s.current
+	} else {
+		s.previous = 0
+	}
+
+	s.current = 0
+	s.bucketStart = s.bucketStart.Add(n * s.window)
+}
+
+// Reset closes the guard and clears the event count.
+func (s *surgeGuardTrigger) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.bucketStart = time.Time{}
+	s.current, s.previous = 0, 0
+	s.tripped = false
+}
+
+func (s *surgeGuardTrigger) Unwrap() Trigger {
+	return s.inner
+}
+
+func (*surgeGuardTrigger) forwardsStart() {}
+
+func (s *surgeGuardTrigger) Stop(ctx context.Context) error {
+	return s.inner.Stop(ctx)
+}
+
+func (s *surgeGuardTrigger) Validate(ctx context.Context) error {
+	return s.inner.Validate(ctx)
+}
@@ -0,0 +1,133 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// callbackTrigger records the callback it is started with, so tests can fire
+// events through it.
+type callbackTrigger struct {
+	callback TriggerCallback
+}
+
+func (c *callbackTrigger) Start(_ context.Context, callback TriggerCallback) error {
+	c.callback = callback
+	return nil
+}
+
+func (*callbackTrigger) Stop(context.Context) error     { return nil }
+func (*callbackTrigger) Validate(context.Context) error { return nil }
+
+func (c *callbackTrigger) fire() error {
+	return c.callback(context.Background(), TriggerEvent{})
+}
+
+func TestSurgeGuardTrips(t *testing.T) {
+	inner := &callbackTrigger{}
+
+	var trips []int
+	trigger := WithSurgeGuard(inner, 3, time.Hour, func(count int) { trips = append(trips, count) })
+
+	delivered := 0
+	if err := trigger.Start(context.Background(), func(context.Context, TriggerEvent) error {
+		delivered++
+		return nil
+	}); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	for i := range 6 {
+		err := inner.fire()
+		if want := i >= 3; errors.Is(err, ErrSurgeTripped) != want {
+			t.Fatalf("event %d: err = %v, want tripped = %v", i, err, want)
+		}
+	}
+
+	if delivered != 3 {
+		t.Errorf("delivered = %d, want 3", delivered)
+	}
+
+	if len(trips) != 1 || trips[0] != 4 {
+		t.Errorf("onTrip calls = %v, want a single call with 4", trips)
+	}
+
+	trigger.(Resetter).Reset()
+
+	if err := inner.fire(); err != nil {
+		t.Fatalf("event after Reset: %v", err)
+	}
+}
+
+func TestSurgeGuardWindowRotation(t *testing.T) {
+	s := WithSurgeGuard(&callbackTrigger{}, 3, time.Second, nil).(*surgeGuardTrigger)
+	t0 := time.Unix(1000, 0)
+
+	for i := range 5 {
+		allowed, tripCount := s.admit(t0)
+		if want := i < 3; allowed != want {
+			t.Fatalf("event %d: allowed = %v, want %v", i, allowed, want)
+		}
+
+		want := 0
+		if i == 3 {
+			want = 4
+		}
+
+		if tripCount != want {
+			t.Fatalf("event %d: tripCount = %d, want %d", i, tripCount, want)
+		}
+	}
+
+	// Halfway through the next bucket the previous one weighs 5 * 0.5, so one
+	// more event brings the estimate to 3 and closes the guard.
+	if allowed, _ := s.admit(t0.Add(1500 * time.Millisecond)); !allowed {
+		t.Fatal("event halfway through the next window was blocked")
+	}
+
+	if s.previous != 5 || s.current != 1 || !s.bucketStart.Equal(t0.Add(time.Second)) {
+		t.Fatalf("after rotation: previous = %d, current = %d, bucketStart = %v", s.previous, s.current, s.bucketStart)
+	}
+
+	// Skipping more than a whole window drops both buckets.
+	if allowed, _ := s.admit(t0.Add(3200 * time.Millisecond)); !allowed {
+		t.Fatal("event after an idle window was blocked")
+	}
+
+	if s.previous != 0 || s.current != 1 || !s.bucketStart.Equal(t0.Add(3*time.Second)) {
+		t.Errorf("after skipping windows: previous = %d, current = %d, bucketStart = %v", s.previous, s.current, s.bucketStart)
+	}
+}
+
+func TestSurgeGuardBlockedEventsCount(t *testing.T) {
+	s := WithSurgeGuard(&callbackTrigger{}, 2, time.Second, nil).(*surgeGuardTrigger)
+	t0 := time.Unix(1000, 0)
+
+	for range 10 {
+		s.admit(t0)
+	}
+
+	// The previous bucket still weighs 10 * 0.9, so the guard stays tripped
+	// and does not report a new trip.
+	allowed, tripCount := s.admit(t0.Add(1100 * time.Millisecond))
+	if allowed || tripCount != 0 {
+		t.Errorf("admit = (%v, %d), want (false, 0)", allowed, tripCount)
+	}
+}
+
+func TestSurgeGuardDisabled(t *testing.T) {
+	inner := &callbackTrigger{}
+	trigger := WithSurgeGuard(inner, 0, time.Second, func(int) { t.Error("onTrip called on a disabled guard") })
+
+	if err := trigger.Start(context.Background(), func(context.Context, TriggerEvent) error { return nil }); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	for range 100 {
+		if err := inner.fire(); err != nil {
+			t.Fatalf("fire: %v", err)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package interfaces
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// WithTimeout returns an Action whose Execute returns after at most d, even if
+// a ignores ctx cancellation. On overrun it returns the child context's error,
+// context.DeadlineExceeded.
+//
+// The wrapper cannot stop the goroutine running a's Execute. If that goroutine
+// finishes after the timeout, its late completion and result error are logged
+// at warn level so leaks stay visible. A panic in a's Execute is returned as a
+// *PanicError, since it happens on that goroutine. A non-positive d returns a
+// unchanged.
+func WithTimeout(a Action, d time.Duration) Action {
+	if d <= 0 {
+		return a
+	}
+
+	return wrapAction(a, func(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger, call ExecuteFunc) (*ActionResult, error) {
+		return callWithTimeout(ctx, d, call, executionCtx, logger)
+	})
+}
+
+type executeOutcome struct {
+	result *ActionResult
+	err    error
+}
+
+func callWithTimeout(ctx context.Context, timeout time.Duration, call ExecuteFunc, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan executeOutcome, 1)
+
+	go func() {
+		// The call runs on its own goroutine, where a panic could not be
+		// recovered by the caller's RecoverMiddleware or SafeExecute.
+		result, err := safeCall(ctx, call, executionCtx, logger)
+		done <- executeOutcome{result: result, err: err}
+	}()
+
+	select {
+	case out := <-done:
+		return out.result, out.err
+	case <-ctx.Done():
+		go func() {
+			out := <-done
+			orDefaultLogger(logger).Warn("action finished after timeout",
+				"timeout", timeout,
+				"elapsed", time.Since(start),
+				"error", out.err,
+			)
+		}()
+
+		return nil, ctx.Err()
+	}
+}
@@ -3,16 +3,60 @@ package interfaces
 import (
 	"context"
 	"log/slog"
+	"time"
 )
 
-type TriggerCallback func(ctx context.Context, data map[string]any) error
+// TriggerEvent is a single event emitted by a trigger.
+type TriggerEvent struct {
+	// TriggerID identifies the trigger that emitted the event.
+	TriggerID string
+	// EmittedAt is when the event occurred at the source.
+	EmittedAt time.Time
+	// DedupKey identifies the event across redeliveries from at-least-once
+	// sources. It is empty if the source has no stable event identity.
+	DedupKey string
+	// Data is the event payload.
+	Data map[string]any
+	// Checkpoint is the cursor a ResumableTrigger resumes from once this event
+	// has been processed. It is nil for triggers that are not resumable and
+	// for events that do not advance the cursor.
+	Checkpoint []byte
+}
+
+// TriggerCallback receives the events of a trigger. Its error tells
+// at-least-once triggers how to settle the event; see OutcomeOf.
+type TriggerCallback func(ctx context.Context, event TriggerEvent) error
+
+// CallbackAdapter adapts a callback written against the old
+// func(ctx, data) signature to a TriggerCallback that receives only the
+// event's Data.
+func CallbackAdapter(old func(ctx context.Context, data map[string]any) error) TriggerCallback {
+	return func(ctx context.Context, event TriggerEvent) error {
+		return old(ctx, event.Data)
+	}
+}
 
+// Trigger produces events that start workflow executions.
+//
+// A trigger is not necessarily ready to receive events when Start returns;
+// triggers that finish setting up asynchronously implement ReadyNotifier.
+//
+// Stop must stop producing new callbacks and then block until every
+// outstanding callback has returned or ctx expires, whichever comes first.
+// In-flight callbacks are not aborted, so an event that is being processed
+// when Stop is called is not lost.
 type Trigger interface {
 	Start(ctx context.Context, callback TriggerCallback) error
 	Stop(ctx context.Context) error
 	Validate(ctx context.Context) error
 }
 
+// Draining is an optional interface for triggers that can report how many
+// callbacks are still running, e.g. while Stop is waiting for them.
+type Draining interface {
+	InFlight() int
+}
+
 type TriggerFactory interface {
 	Create(ctx context.Context, config map[string]any, logger *slog.Logger) (Trigger, error)
 	ID() string
@@ -0,0 +1,79 @@
+package interfaces
+
+import (
+	"context"
+	"reflect"
+)
+
+// TriggerUnwrapper is implemented by triggers that decorate a single inner
+// trigger, such as the ones returned by WithRateLimit, WithDedup,
+// NewRoutingTrigger and WithSurgeGuard. Unwrap returns the decorated trigger.
+//
+// WaitReady, CheckHealth and SetPaused follow Unwrap, so decorating a trigger
+// does not hide its ReadyNotifier, HealthChecker or Pausable. The wrappers in
+// this package also implement ResumableTrigger by resuming the inner trigger
+// with their own callback logic, so StartWithCheckpoints works through them.
+type TriggerUnwrapper interface {
+	Unwrap() Trigger
+}
+
+// TriggerAs returns the first trigger in t's chain that implements T,
+// starting with t itself and following Unwrap. For ResumableTrigger it skips
+// the forwarding wrappers of this package and reports the implementation
+// their forwarding reaches.
+func TriggerAs[T any](t Trigger) (T, bool) {
+	forwarded := reflect.TypeFor[T]() == reflect.TypeFor[ResumableTrigger]()
+
+	for t != nil {
+		if _, fw := t.(startForwarder); !fw || !forwarded {
+			if v, ok := any(t).(T); ok {
+				return v, true
+			}
+		}
+
+		u, ok := t.(TriggerUnwrapper)
+		if !ok {
+			break
+		}
+
+		t = u.Unwrap()
+	}
+
+	var zero T
+
+	return zero, false
+}
+
+// startForwarder is implemented by the trigger wrappers in this package,
+// whose StartFrom exists whether or not the wrapped trigger is resumable.
+type startForwarder interface {
+	TriggerUnwrapper
+	forwardsStart()
+}
+
+// supportsResume reports whether starting t with startFrom reaches a
+// StartFrom implementation.
+func supportsResume(t Trigger) bool {
+	for {
+		if _, ok := t.(ResumableTrigger); !ok {
+			return false
+		}
+
+		fw, ok := t.(startForwarder)
+		if !ok {
+			return true
+		}
+
+		t = fw.Unwrap()
+	}
+}
+
+// startFrom starts t from checkpoint if it is resumable and with Start
+// otherwise.
+func startFrom(ctx context.Context, t Trigger, checkpoint []byte, callback TriggerCallback) error {
+	if rt, ok := t.(ResumableTrigger); ok {
+		return rt.StartFrom(ctx, checkpoint, callback)
+	}
+
+	return t.Start(ctx, callback)
+}
@@ -0,0 +1,75 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"time"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// TypedAction is an Action whose Execute returns a statically typed output.
+// Use AsAction to satisfy the untyped Action interface.
+type TypedAction[O any] interface {
+	Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (O, error)
+	Validate(ctx context.Context) error
+}
+
+// AsAction adapts ta to the Action interface, storing its typed output in
+// ActionResult.Output.
+func AsAction[O any](ta TypedAction[O]) Action {
+	return typedAction[O]{inner: ta}
+}
+
+type typedAction[O any] struct {
+	inner TypedAction[O]
+}
+
+func (a typedAction[O]) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	start := time.Now()
+
+	output, err := a.inner.Execute(ctx, executionCtx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ActionResult{Output: output, Duration: time.Since(start)}, nil
+}
+
+func (a typedAction[O]) Validate(ctx context.Context) error {
+	return a.inner.Validate(ctx)
+}
+
+func (a typedAction[O]) Close() error {
+	if c, ok := a.inner.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+// TypedResult executes a and returns its output as an O. It returns an error
+// if the output's concrete type is not O.
+func TypedResult[O any](ctx context.Context, a Action, executionCtx models.ExecutionContext, logger *slog.Logger) (O, error) {
+	var zero O
+
+	result, err := a.Execute(ctx, executionCtx, logger)
+	if err != nil {
+		return zero, err
+	}
+
+	var output any
+	if result != nil {
+		output = result.Output
+	}
+
+	typed, ok := output.(O)
+	if !ok {
+		return zero, fmt.Errorf("interfaces: action output has type %T, want %s", output, reflect.TypeFor[O]())
+	}
+
+	return typed, nil
+}
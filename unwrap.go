@@ -0,0 +1,111 @@
+package interfaces
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// Unwrapper is implemented by actions that decorate a single inner action,
+// such as the ones returned by the middlewares and wrappers in this package.
+// Unwrap returns the decorated action.
+//
+// The wrappers in this package also implement DryRunner and IdempotentAction
+// by running their own logic (timeouts, result mapping, observers, ...)
+// around the inner action's implementation, so ExecuteDryRun and
+// ExecuteWithIdempotency called on the outer action behave like Execute. They
+// do not implement BatchAction, StreamingAction or Cloneable, whose calls they
+// cannot decorate; ExecuteMany and CloneOrCreate fall back to Execute and
+// Create for them.
+type Unwrapper interface {
+	Unwrap() Action
+}
+
+// ActionAs returns the first action in a's chain that implements T, starting
+// with a itself and following Unwrap. For DryRunner and IdempotentAction it
+// skips the forwarding wrappers of this package and reports the
+// implementation their forwarding reaches.
+//
+// Use ActionAs to inspect an action, as DetectCapabilities and MetricsOf do.
+// Calling a delegated method such as DryRun on the result skips the wrappers
+// in between; call the Execute helpers on the outer action instead.
+func ActionAs[T any](a Action) (T, bool) {
+	forwarded := isForwarded[T]()
+
+	for a != nil {
+		if _, fw := a.(callForwarder); !fw || !forwarded {
+			if t, ok := any(a).(T); ok {
+				return t, true
+			}
+		}
+
+		u, ok := a.(Unwrapper)
+		if !ok {
+			break
+		}
+
+		a = u.Unwrap()
+	}
+
+	var zero T
+
+	return zero, false
+}
+
+// callForwarder is implemented by the wrappers in this package, whose DryRun
+// and ExecuteIdempotent methods exist whether or not the wrapped action
+// supports them.
+type callForwarder interface {
+	Unwrapper
+	forwardsCalls()
+}
+
+func isForwarded[T any]() bool {
+	t := reflect.TypeFor[T]()
+	return t == reflect.TypeFor[DryRunner]() || t == reflect.TypeFor[IdempotentAction]()
+}
+
+// aroundFunc is a wrapper's logic around call, the wrapped operation. Running
+// the same logic around Execute, DryRun and ExecuteIdempotent keeps the
+// wrapper's behavior for all three.
+type aroundFunc func(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger, call ExecuteFunc) (*ActionResult, error)
+
+// supportsDryRun reports whether ExecuteDryRun on a reaches a DryRun
+// implementation.
+func supportsDryRun(a Action) bool {
+	for {
+		if _, ok := a.(DryRunner); !ok {
+			return false
+		}
+
+		fw, ok := a.(callForwarder)
+		if !ok {
+			return true
+		}
+
+		a = fw.Unwrap()
+	}
+}
+
+// forwardDryRun runs around with the dry run of inner as the wrapped call. It
+// returns ErrDryRunUnsupported without running around if inner cannot dry
+// run.
+func forwardDryRun(ctx context.Context, inner Action, executionCtx models.ExecutionContext, logger *slog.Logger, around aroundFunc) (*ActionResult, error) {
+	if !supportsDryRun(inner) {
+		return nil, ErrDryRunUnsupported
+	}
+
+	return around(ctx, executionCtx, logger, func(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+		return ExecuteDryRun(ctx, inner, executionCtx, logger)
+	})
+}
+
+// forwardIdempotent runs around with the idempotent execution of inner under
+// key as the wrapped call.
+func forwardIdempotent(ctx context.Context, inner Action, key string, executionCtx models.ExecutionContext, logger *slog.Logger, around aroundFunc) (*ActionResult, error) {
+	return around(ctx, executionCtx, logger, func(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+		return ExecuteWithIdempotency(ctx, inner, key, executionCtx, logger)
+	})
+}
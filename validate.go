@@ -0,0 +1,102 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Validator is implemented by both Action and Trigger.
+type Validator interface {
+	Validate(ctx context.Context) error
+}
+
+// DeepValidator is an optional interface for actions and triggers that can
+// verify live preconditions, e.g. that a database table exists or a Kafka
+// topic is reachable. Unlike the cheap, static Validate, ValidateDeep may
+// touch the network and should honor the context deadline.
+type DeepValidator interface {
+	ValidateDeep(ctx context.Context) error
+}
+
+// ValidateAll runs v's Validate and, if deep is true and v implements
+// DeepValidator, its ValidateDeep. v is usually an Action or a Trigger. The
+// deep check only runs if the static one passes.
+func ValidateAll(ctx context.Context, v any, deep bool) error {
+	validator, ok := v.(Validator)
+	if !ok {
+		return fmt.Errorf("interfaces: %T does not implement Validate", v)
+	}
+
+	if err := validator.Validate(ctx); err != nil {
+		return err
+	}
+
+	if dv, ok := v.(DeepValidator); ok && deep {
+		return dv.ValidateDeep(ctx)
+	}
+
+	return nil
+}
+
+// FieldError describes an invalid config field.
+type FieldError struct {
+	// Path locates the field, e.g. a JSON Pointer such as "/headers/0".
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+
+	return e.Path + ": " + e.Message
+}
+
+// ValidationError aggregates the field errors found while validating a
+// config. It can be returned from Validate and marshals to JSON as
+// {"errors": [{"path": ..., "message": ...}]}.
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+// NewValidationError returns an empty ValidationError.
+func NewValidationError() *ValidationError {
+	return &ValidationError{Errors: []FieldError{}}
+}
+
+// Add records an error for the field at path and returns e for chaining.
+func (e *ValidationError) Add(path, message string) *ValidationError {
+	e.Errors = append(e.Errors, FieldError{Path: path, Message: message})
+	return e
+}
+
+// ErrOrNil returns e if it holds any field errors and nil otherwise, so
+// Validate implementations can end with "return verr.ErrOrNil()".
+func (e *ValidationError) ErrOrNil() error {
+	if e == nil || len(e.Errors) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+
+	return "interfaces: invalid config: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the individual FieldErrors, so errors.As can extract them.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, fe := range e.Errors {
+		errs[i] = fe
+	}
+
+	return errs
+}
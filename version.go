@@ -0,0 +1,47 @@
+package interfaces
+
+import "fmt"
+
+// Versioned is an optional interface for factories whose config schema
+// evolves over time.
+//
+// Version returns the factory's current semantic version. A workflow stores
+// this version alongside the step config, and SupportsConfigVersion reports
+// whether a config saved against version v can still be interpreted.
+type Versioned interface {
+	Version() string
+	SupportsConfigVersion(v string) bool
+}
+
+// VersionMismatchError reports a config saved against a version that the
+// factory no longer supports.
+type VersionMismatchError struct {
+	FactoryID      string
+	FactoryVersion string
+	SavedVersion   string
+}
+
+func (e *VersionMismatchError) Error() string {
+	return fmt.Sprintf("interfaces: factory %q version %s does not support config version %s",
+		e.FactoryID, e.FactoryVersion, e.SavedVersion)
+}
+
+// CheckVersion returns a *VersionMismatchError if f implements Versioned and
+// does not support savedVersion. Factories that are not versioned, and
+// configs saved without a version, are always accepted.
+func CheckVersion(f ActionFactory, savedVersion string) error {
+	v, ok := f.(Versioned)
+	if !ok || savedVersion == "" {
+		return nil
+	}
+
+	if v.SupportsConfigVersion(savedVersion) {
+		return nil
+	}
+
+	return &VersionMismatchError{
+		FactoryID:      f.ID(),
+		FactoryVersion: v.Version(),
+		SavedVersion:   savedVersion,
+	}
+}
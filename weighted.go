@@ -0,0 +1,151 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"math/rand/v2"
+	"sync"
+
+	"github.com/dukex/operion/pkg/models"
+)
+
+// Metadata keys set by weighted actions on the chosen variant's result.
+const (
+	MetadataVariantIndex = "variant_index"
+	MetadataVariantName  = "variant_name"
+)
+
+// WeightedAction is one variant of a weighted action.
+type WeightedAction struct {
+	Action Action
+	// Weight is the variant's relative chance of being chosen. A zero
+	// weight disables the variant.
+	Weight int
+	// Name optionally labels the variant in the result metadata.
+	Name string
+}
+
+// WeightedOption configures NewWeighted.
+type WeightedOption func(*weightedAction)
+
+// WithRand makes the weighted action draw variants from r instead of the
+// global random source, e.g. to get deterministic choices in tests.
+func WithRand(r *rand.Rand) WeightedOption {
+	return func(w *weightedAction) {
+		w.rand = r
+	}
+}
+
+// NewWeighted returns an Action that executes one of choices, picked at
+// random in proportion to its weight, on every Execute call. The index of the
+// chosen variant, and its name if set, are added to the result's Metadata
+// under MetadataVariantIndex and MetadataVariantName. Validate validates every
+// variant.
+//
+// It returns an error if a choice has no action or a negative weight, or if
+// the weights sum to zero.
+func NewWeighted(choices []WeightedAction, opts ...WeightedOption) (Action, error) {
+	total := 0
+
+	for i, choice := range choices {
+		if choice.Action == nil {
+			return nil, fmt.Errorf("interfaces: weighted choice %d has no action", i)
+		}
+
+		if choice.Weight < 0 {
+			return nil, fmt.Errorf("interfaces: weighted choice %d has negative weight %d", i, choice.Weight)
+		}
+
+		total += choice.Weight
+	}
+
+	if total == 0 {
+		return nil, errors.New("interfaces: weighted choices must have a positive total weight")
+	}
+
+	w := &weightedAction{choices: choices, total: total}
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w, nil
+}
+
+type weightedAction struct {
+	choices []WeightedAction
+	total   int
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+func (w *weightedAction) pick() int {
+	var n int
+
+	if w.rand != nil {
+		// rand.Rand is not safe for concurrent use.
+		w.mu.Lock()
+		n = w.rand.IntN(w.total)
+		w.mu.Unlock()
+	} else {
+		n = rand.IntN(w.total)
+	}
+
+	for i, choice := range w.choices {
+		if n < choice.Weight {
+			return i
+		}
+
+		n -= choice.Weight
+	}
+
+	return len(w.choices) - 1
+}
+
+func (w *weightedAction) Execute(ctx context.Context, executionCtx models.ExecutionContext, logger *slog.Logger) (*ActionResult, error) {
+	i := w.pick()
+	choice := w.choices[i]
+
+	result, err := choice.Action.Execute(ctx, executionCtx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		result = &ActionResult{}
+	}
+
+	result.Metadata = maps.Clone(result.Metadata)
+	if result.Metadata == nil {
+		result.Metadata = make(map[string]any, 2)
+	}
+
+	result.Metadata[MetadataVariantIndex] = i
+	if choice.Name != "" {
+		result.Metadata[MetadataVariantName] = choice.Name
+	}
+
+	return result, nil
+}
+
+func (w *weightedAction) Validate(ctx context.Context) error {
+	for i, choice := range w.choices {
+		if err := choice.Action.Validate(ctx); err != nil {
+			return fmt.Errorf("interfaces: weighted choice %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *weightedAction) Close() error {
+	actions := make([]Action, len(w.choices))
+	for i, choice := range w.choices {
+		actions[i] = choice.Action
+	}
+
+	return CloseAll(actions...)
+}